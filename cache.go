@@ -0,0 +1,183 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/chromedp/cdproto-gen/util"
+)
+
+// cacheUsage is the usage of the cache command.
+const cacheUsage = `usage: %s cache [-cache dir] <command> [args]
+
+Commands:
+  list                      list cached files
+  verify                    verify cached files against their sha256
+  prune -older-than <dur>   remove files retrieved longer ago than dur
+  export <file.tar.gz>      write cached files to a tarball (- for stdout)
+  import <file.tar.gz>      read cached files from a tarball (- for stdin)
+`
+
+// runCache runs the cache command, managing the protocol cache directory.
+func runCache(args []string) error {
+	fs := flag.NewFlagSet("cache", flag.ExitOnError)
+	fs.StringVar(flagCache, "cache", "", "protocol cache directory")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), cacheUsage, os.Args[0])
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if err := setCache(); err != nil {
+		return err
+	}
+
+	cmd, args := fs.Arg(0), fs.Args()[1:]
+	switch cmd {
+	case "list":
+		return cacheList(args)
+	case "verify":
+		return cacheVerify(args)
+	case "prune":
+		return cachePrune(args)
+	case "export":
+		return cacheExport(args)
+	case "import":
+		return cacheImport(args)
+	}
+	return fmt.Errorf("unknown cache command %q", cmd)
+}
+
+// cacheList lists the cached files.
+func cacheList(args []string) error {
+	if len(args) != 0 {
+		return errors.New("list takes no arguments")
+	}
+	entries, err := util.Entries(*flagCache)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSIZE\tFETCHED\tSHA256\tURL")
+	for _, e := range entries {
+		if e.Err != nil {
+			util.Logf("INVALID: %s: %v", e.Name, e.Err)
+		}
+		if e.Missing {
+			continue
+		}
+		sum, urlstr := e.Meta.SHA256, e.Meta.URL
+		if len(sum) > 12 {
+			sum = sum[:12]
+		}
+		if sum == "" {
+			sum = "-"
+		}
+		if urlstr == "" {
+			urlstr = "-"
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", e.Name, e.Meta.Size, e.Meta.Fetched.Format(time.RFC3339), sum, urlstr)
+	}
+	return w.Flush()
+}
+
+// cacheVerify verifies the cached files against their metadata. Files
+// without metadata are reported, but do not fail verification. Files with
+// invalid metadata fail verification.
+func cacheVerify(args []string) error {
+	if len(args) != 0 {
+		return errors.New("verify takes no arguments")
+	}
+	entries, err := util.Entries(*flagCache)
+	if err != nil {
+		return err
+	}
+	var failed, unverified int
+	for _, e := range entries {
+		switch err := util.VerifyEntry(*flagCache, e); {
+		case !e.HasMeta:
+			util.Logf("UNVERIFIED: %s: no metadata", e.Name)
+			unverified++
+		case err != nil:
+			util.Logf("FAILED: %s: %v", e.Name, err)
+			failed++
+		}
+	}
+	util.Logf("VERIFIED: %d files (%d failed, %d unverified)", len(entries)-unverified, failed, unverified)
+	if failed != 0 {
+		return fmt.Errorf("%d files failed verification", failed)
+	}
+	return nil
+}
+
+// cachePrune removes cached files retrieved longer ago than -older-than.
+func cachePrune(args []string) error {
+	fs := flag.NewFlagSet("cache prune", flag.ExitOnError)
+	olderThan := fs.Duration("older-than", 0, "remove files retrieved longer ago than the duration")
+	_ = fs.Parse(args)
+	switch {
+	case fs.NArg() != 0:
+		return errors.New("prune takes no arguments")
+	case *olderThan <= 0:
+		return errors.New("prune requires a positive -older-than duration")
+	}
+	removed, err := util.Prune(*flagCache, time.Now().Add(-*olderThan))
+	for _, e := range removed {
+		util.Logf("REMOVED: %s", e.Name)
+	}
+	if err != nil {
+		return err
+	}
+	util.Logf("PRUNED: %d files", len(removed))
+	return nil
+}
+
+// cacheExport writes the cached files to a tarball.
+func cacheExport(args []string) error {
+	if len(args) != 1 {
+		return errors.New("export requires a tarball path")
+	}
+	if args[0] == "-" {
+		return util.Export(*flagCache, os.Stdout)
+	}
+	f, err := os.Create(args[0])
+	if err != nil {
+		return err
+	}
+	if err = util.Export(*flagCache, f); err != nil {
+		f.Close()
+		return err
+	}
+	util.Logf("WRITING: %s", args[0])
+	return f.Close()
+}
+
+// cacheImport reads cached files from a tarball.
+func cacheImport(args []string) error {
+	if len(args) != 1 {
+		return errors.New("import requires a tarball path")
+	}
+	var r io.Reader = os.Stdin
+	if args[0] != "-" {
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+	names, err := util.Import(*flagCache, r)
+	if err != nil {
+		return fmt.Errorf("import: %v", err)
+	}
+	util.Logf("IMPORTED: %d files to %s", len(names), *flagCache)
+	return nil
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/chromedp/cdproto-gen/pdl"
+	"github.com/chromedp/cdproto-gen/util"
+)
+
+// compatGo is the name of the root package file containing the compatibility
+// table.
+const compatGo = "compat.go"
+
+// compat is the first chromium major version supporting each command and
+// event of the generated protocol definitions (ie, the start of the latest
+// unbroken run of history versions containing the command or event).
+type compat struct {
+	// oldest and current are the major versions of the oldest history version
+	// and of the generated protocol definitions.
+	oldest, current int
+
+	// since are the first major versions supporting each command and event
+	// through the current version, keyed by method (ie, Page.navigate).
+	since map[string]int
+}
+
+// loadCompat determines the chromium versions supporting each command and
+// event of the protocol definitions, by indexing the versions in the cache's
+// history (see history fetch) matching -compat and older than the protocol
+// definitions, recording the history files read as inputs in the lock. The
+// browser version is used when the chromium version of the protocol
+// definitions is not known (ie, when retrieved from a devtools endpoint).
+func loadCompat(lk *locker, protoDefs *pdl.PDL, browserVer *util.BrowserVersion) (*compat, error) {
+	spec, err := util.ParseVersionSpec(*flagCompat)
+	if err != nil {
+		return nil, fmt.Errorf("-compat: %v", err)
+	}
+
+	// determine current version
+	current := *flagChromium
+	if current == "" && browserVer != nil {
+		current = browserVer.ChromeVersion()
+	}
+	cur, err := util.ParseChromeVersion(current)
+	if err != nil {
+		return nil, fmt.Errorf("-compat requires the chromium version of the protocol definitions (see -chromium): %v", err)
+	}
+
+	// determine history versions
+	dir := filepath.Join(*flagCache, "pdl", "combined")
+	h, err := readHistory(dir)
+	if err != nil {
+		return nil, err
+	}
+	var matched bool
+	var versions []HistoryVersion
+	for _, v := range h.Versions {
+		if v.Error != "" || v.File == "" || !spec.MatchString(v.Chromium) {
+			continue
+		}
+		matched = true
+		if util.CompareVersions(v.Chromium, current) {
+			versions = append(versions, v)
+		}
+	}
+	if !matched {
+		return nil, fmt.Errorf("no versions in history matching -compat %q; run history fetch first", *flagCompat)
+	}
+	oldest := cur
+	if len(versions) != 0 {
+		if oldest, err = util.ParseChromeVersion(versions[0].Chromium); err != nil {
+			return nil, err
+		}
+	}
+	util.Logf("COMPAT: %d versions (%s through %s)", len(versions)+1, oldest, current)
+
+	// index history versions, followed by the protocol definitions
+	idx := pdl.NewIndex()
+	for _, v := range versions {
+		buf, _, err := util.ReadEntry(filepath.Join(dir, v.File))
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(buf)
+		if hex.EncodeToString(sum[:]) != v.SHA256 {
+			return nil, fmt.Errorf("%s: sha256 does not match %s", v.File, historyFile)
+		}
+		if err = lk.input("history", "", v.File, buf); err != nil {
+			return nil, err
+		}
+		p, err := pdl.Parse(buf, pdl.WithFilename(v.File))
+		if err != nil {
+			return nil, err
+		}
+		idx.Add(v.Chromium, p)
+	}
+	idx.Add(current, protoDefs)
+
+	c := &compat{
+		oldest:  oldest.Major,
+		current: cur.Major,
+		since:   make(map[string]int),
+	}
+	for _, item := range idx.Items {
+		if (item.Element != "command" && item.Element != "event") || item.Until != current {
+			continue
+		}
+		// start of the latest run of versions supporting the method, as
+		// methods removed and later re-added are not supported in between
+		ver := item.Since
+		if item.Resumed != "" {
+			ver = item.Resumed
+		}
+		since, err := util.ParseChromeVersion(ver)
+		if err != nil {
+			return nil, err
+		}
+		c.since[item.Path] = since.Major
+	}
+	return c, nil
+}
+
+// apply sets the supported chromium versions of the commands and events of
+// the domains, added to their generated docs.
+func (c *compat) apply(domains []*pdl.Domain) {
+	for _, d := range domains {
+		for _, typs := range [][]*pdl.Type{d.Commands, d.Events} {
+			for _, t := range typs {
+				if since, ok := c.since[d.Domain.String()+"."+t.Name]; ok {
+					t.Supported = "Chrome " + strconv.Itoa(since) + "–current"
+				}
+			}
+		}
+	}
+}
+
+// file returns the go source for the root package containing the
+// compatibility table of the commands and events of the domains.
+func (c *compat) file(pkgName string, domains []*pdl.Domain) *bytes.Buffer {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "// Code generated by cdproto-gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "package %s\n\n", pkgName)
+	fmt.Fprintf(buf, "// Chrome major versions of the protocol definitions the compatibility table\n")
+	fmt.Fprintf(buf, "// was determined from.\n")
+	fmt.Fprintf(buf, "const (\n")
+	fmt.Fprintf(buf, "\t// CompatOldest is the oldest Chrome major version. Commands and events\n")
+	fmt.Fprintf(buf, "\t// supported since it may also be supported by earlier versions.\n")
+	fmt.Fprintf(buf, "\tCompatOldest = %d\n\n", c.oldest)
+	fmt.Fprintf(buf, "\t// CompatCurrent is the Chrome major version of the generated protocol\n")
+	fmt.Fprintf(buf, "\t// definitions.\n")
+	fmt.Fprintf(buf, "\tCompatCurrent = %d\n", c.current)
+	fmt.Fprintf(buf, ")\n\n")
+	fmt.Fprintf(buf, "// Compat is the first Chrome major version supporting each command and event.\n")
+	fmt.Fprintf(buf, "var Compat = map[MethodType]int{\n")
+	for _, d := range domains {
+		for _, typs := range [][]*pdl.Type{d.Commands, d.Events} {
+			for _, t := range typs {
+				method := d.Domain.String() + "." + t.Name
+				if since, ok := c.since[method]; ok {
+					fmt.Fprintf(buf, "\t%q: %d,\n", method, since)
+				}
+			}
+		}
+	}
+	fmt.Fprintf(buf, "}\n\n")
+	fmt.Fprintf(buf, "// Supported returns true when the Chrome major version supports the command\n")
+	fmt.Fprintf(buf, "// or event.\n")
+	fmt.Fprintf(buf, "func Supported(method MethodType, major int) bool {\n")
+	fmt.Fprintf(buf, "\tsince, ok := Compat[method]\n")
+	fmt.Fprintf(buf, "\treturn ok && since <= major\n")
+	fmt.Fprintf(buf, "}\n")
+	return buf
+}
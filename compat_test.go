@@ -0,0 +1,78 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/chromedp/cdproto-gen/pdl"
+	"github.com/chromedp/cdproto-gen/util"
+)
+
+func TestLoadCompat(t *testing.T) {
+	defer func(logf func(string, ...interface{})) { util.Logf = logf }(util.Logf)
+	defer func(cache, compat, chromium string) {
+		*flagCache, *flagCompat, *flagChromium = cache, compat, chromium
+	}(*flagCache, *flagCompat, *flagChromium)
+	util.Logf = t.Logf
+
+	dir, err := ioutil.TempDir("", "cdproto-gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	*flagCache, *flagCompat, *flagChromium = dir, ">=118", "121.0.6167.85"
+
+	// history
+	combined := filepath.Join(dir, "pdl", "combined")
+	if err := os.MkdirAll(combined, 0755); err != nil {
+		t.Fatal(err)
+	}
+	h := new(History)
+	for _, v := range []struct {
+		ver string
+		buf string
+	}{
+		{"118.0.5993.70", "domain A\n  command a\n  command b\n  event e\n"},
+		{"119.0.6045.105", "domain A\n  command a\n  event e\n"},
+		{"120.0.6099.109", "domain A\n  command a\n  command b\n  command c\n"},
+	} {
+		name := v.ver + "_12.0.267.8.pdl"
+		if err := util.WriteEntry(filepath.Join(combined, name), []byte(v.buf), util.Meta{}); err != nil {
+			t.Fatal(err)
+		}
+		sum := sha256.Sum256([]byte(v.buf))
+		h.set(HistoryVersion{Chromium: v.ver, V8: "12.0.267.8", File: name, SHA256: hex.EncodeToString(sum[:])})
+	}
+	if err := h.write(combined); err != nil {
+		t.Fatal(err)
+	}
+
+	protoDefs, err := pdl.Parse([]byte("domain A\n  command a\n  command b\n  command c\n  command d\n  event e\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := loadCompat(&locker{lock: new(Lock)}, protoDefs, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.oldest != 118 || c.current != 121 {
+		t.Errorf("expected oldest 118 and current 121, got: %d and %d", c.oldest, c.current)
+	}
+	exp := map[string]int{
+		"A.a": 118,
+		// removed in 119, re-added in 120
+		"A.b": 120,
+		"A.c": 120,
+		"A.d": 121,
+		// removed in 120, re-added in 121
+		"A.e": 121,
+	}
+	if !reflect.DeepEqual(c.since, exp) {
+		t.Errorf("expected %v, got: %v", exp, c.since)
+	}
+}
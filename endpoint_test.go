@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"go/format"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/chromedp/cdproto-gen/util"
+)
+
+func TestLoadEndpoint(t *testing.T) {
+	defer func(logf func(string, ...interface{}), retries int) { util.Logf, util.Retries = logf, retries }(util.Logf, util.Retries)
+	defer func(chromium, v8 string) { *flagChromium, *flagV8 = chromium, v8 }(*flagChromium, *flagV8)
+	util.Logf, util.Retries = t.Logf, 0
+
+	protocol, err := ioutil.ReadFile(filepath.Join("pdl", "testdata", "devtools-protocol.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/json/version", "/bad/json/version", "/missing/json/version":
+			_, _ = w.Write([]byte(`{"Browser":"HeadlessChrome/140.0.7339.207","Protocol-Version":"1.3","User-Agent":"Mozilla/5.0 HeadlessChrome/140.0.7339.207","V8-Version":"14.0.365.10"}`))
+		case "/json/protocol":
+			_, _ = w.Write(protocol)
+		case "/bad/json/protocol":
+			_, _ = w.Write([]byte(`{"domains":`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer s.Close()
+
+	lk := &locker{lock: new(Lock)}
+	combined, ver, err := loadEndpoint(context.Background(), nil, lk, s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *flagChromium != "140.0.7339.207" || *flagV8 != "14.0.365.10" {
+		t.Errorf("expected -chromium 140.0.7339.207 and -v8 14.0.365.10, got: %s and %s", *flagChromium, *flagV8)
+	}
+	var domains []string
+	for _, d := range combined.Domains {
+		domains = append(domains, d.Domain.String())
+	}
+	if s := strings.Join(domains, ","); s != "CacheStorage,IO,Fetch,WebAuthn,Console,HAR" {
+		t.Errorf("expected domains CacheStorage,IO,Fetch,WebAuthn,Console,HAR, got: %s", s)
+	}
+	var inputs []string
+	for _, in := range lk.lock.Inputs {
+		inputs = append(inputs, in.Source+":"+in.Name)
+	}
+	if s := strings.Join(inputs, ","); s != "endpoint:json/protocol,har:har.pdl" {
+		t.Errorf("expected lock inputs endpoint:json/protocol,har:har.pdl, got: %s", s)
+	}
+
+	// version file
+	buf := versionFile("cdproto", ver)
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		t.Fatalf("version file is not valid go: %v", err)
+	}
+	for _, exp := range []string{
+		"package cdproto\n",
+		"\tBrowser = \"HeadlessChrome/140.0.7339.207\"\n",
+		"\tBrowserVersion = \"140.0.7339.207\"\n",
+		"\tV8Version = \"14.0.365.10\"\n",
+		"\tProtocolVersion = \"1.3\"\n",
+		"\tUserAgent = \"Mozilla/5.0 HeadlessChrome/140.0.7339.207\"\n",
+	} {
+		if !strings.Contains(buf.String(), exp) {
+			t.Errorf("expected version file to contain %q, got:\n%s", exp, buf)
+		}
+	}
+	if string(src) != buf.String() {
+		t.Errorf("version file is not formatted:\n%s", buf)
+	}
+
+	// errors
+	for _, test := range []struct {
+		prefix string
+		err    string
+	}{
+		{"/missing", "/missing/json/protocol: unexpected status 404 Not Found"},
+		{"/bad", "/bad/json/protocol: "},
+		{"/version", "/version/json/version: unexpected status 404 Not Found"},
+	} {
+		_, _, err := loadEndpoint(context.Background(), nil, &locker{lock: new(Lock)}, s.URL+test.prefix)
+		if err == nil || !strings.HasPrefix(err.Error(), s.URL+test.err) {
+			t.Errorf("expected error starting with %q, got: %v", s.URL+test.err, err)
+		}
+	}
+}
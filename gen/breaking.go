@@ -0,0 +1,296 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chromedp/cdproto-gen/gen/genutil"
+	"github.com/chromedp/cdproto-gen/gen/gotpl"
+	"github.com/chromedp/cdproto-gen/pdl"
+)
+
+// Bump is a semantic version bump.
+type Bump int
+
+// Bump values.
+const (
+	BumpNone Bump = iota
+	BumpPatch
+	BumpMinor
+	BumpMajor
+)
+
+// String satisfies the fmt.Stringer interface.
+func (b Bump) String() string {
+	switch b {
+	case BumpPatch:
+		return "patch"
+	case BumpMinor:
+		return "minor"
+	case BumpMajor:
+		return "major"
+	}
+	return "none"
+}
+
+// APIChange is a change to the generated Go API.
+type APIChange struct {
+	// Change is the protocol definition change.
+	Change pdl.Change
+
+	// Ident is the affected Go identifier (ie, page.NavigateParams.URL).
+	Ident string
+
+	// Bump is the version bump required by the change.
+	Bump Bump
+
+	// Reason describes the change to the Go API.
+	Reason string
+}
+
+// Breaking determines if the change is incompatible with the previous Go API.
+func (c APIChange) Breaking() bool {
+	return c.Bump == BumpMajor
+}
+
+// String satisfies the fmt.Stringer interface.
+func (c APIChange) String() string {
+	return fmt.Sprintf("%s: %s [%s]", c.Ident, c.Reason, c.Bump)
+}
+
+// ClassifyChanges classifies the protocol definition changes by their effect
+// on the Go API generated by NewGoGenerator, omitting changes that do not
+// affect the Go API.
+//
+// Deprecated and redirected domains, types, commands, events, and members are
+// not generated, and as such are treated as having been removed. Go
+// identifiers are derived from the protocol names, and do not reflect any
+// renames made by package fixup. Types marked as IsCircularDep (see
+// pdl.ResolveCircularDeps) are treated as generated in the shared cdp package.
+func ClassifyChanges(cs *pdl.Changeset) []APIChange {
+	var changes []APIChange
+	for _, c := range cs.Changes {
+		od, odefs := resolveChange(cs.Old, c)
+		nd, ndefs := resolveChange(cs.New, c)
+		oldEmitted, newEmitted := emitted(od, odefs), emitted(nd, ndefs)
+
+		// skip changes to members of items that were added, removed, or are
+		// not generated, as the change to the item covers them
+		if n := len(odefs) - 1; n > 0 && c.Element != "enum value" && !(emitted(od, odefs[:n]) && emitted(nd, ndefs[:n])) {
+			continue
+		}
+
+		// determine ident from the new definition, when available
+		d, defs := nd, ndefs
+		if !newEmitted && oldEmitted {
+			d, defs = od, odefs
+		}
+
+		bump, reason := classifyChange(c, d, defs, oldEmitted, newEmitted)
+		if bump == BumpNone {
+			continue
+		}
+		changes = append(changes, APIChange{
+			Change: c,
+			Ident:  changeIdent(c, d, defs),
+			Bump:   bump,
+			Reason: reason,
+		})
+	}
+	return changes
+}
+
+// SuggestBump returns the semantic version bump for the Go API changes.
+func SuggestBump(changes []APIChange) Bump {
+	bump := BumpNone
+	for _, c := range changes {
+		if c.Bump > bump {
+			bump = c.Bump
+		}
+	}
+	return bump
+}
+
+// classifyChange classifies a protocol definition change given whether or not
+// the changed element was generated for the old and new definitions.
+func classifyChange(c pdl.Change, d *pdl.Domain, defs []*pdl.Type, oldEmitted, newEmitted bool) (Bump, string) {
+	switch {
+	case c.Element == "enum value":
+		// enum owner added or removed
+		if !oldEmitted || !newEmitted {
+			return BumpNone, ""
+		}
+		if c.Kind == pdl.ChangeRemoved {
+			return BumpMajor, "enum value removed"
+		}
+		return BumpMinor, "enum value added"
+
+	case !oldEmitted && !newEmitted:
+		return BumpNone, ""
+
+	case oldEmitted && !newEmitted:
+		switch c.Attr {
+		case "deprecated":
+			return BumpMajor, c.Element + " deprecated (no longer generated)"
+		case "redirect":
+			return BumpMajor, c.Element + " redirected to " + c.New + " (no longer generated)"
+		}
+		if c.Element == "return value" {
+			return BumpMajor, c.Element + " removed (changes " + commandDo(d, defs[0]) + " signature)"
+		}
+		return BumpMajor, c.Element + " removed"
+
+	case !oldEmitted && newEmitted:
+		switch {
+		case c.Element == "parameter" && c.Item == "command" && !defs[len(defs)-1].Optional:
+			return BumpMajor, "required parameter added to " + pkgName(d, defs[0]) + "." + gotpl.CamelName(defs[0])
+		case c.Element == "return value":
+			return BumpMajor, c.Element + " added (changes " + commandDo(d, defs[0]) + " signature)"
+		}
+		return BumpMinor, c.Element + " added"
+	}
+
+	// generated for both old and new definitions
+	switch {
+	case c.Attr == "type":
+		return BumpMajor, "type changed from " + c.Old + " to " + c.New
+	case c.Attr == "optional" && c.Element == "parameter" && c.Item == "command":
+		return BumpMajor, "parameter optional changed from " + c.Old + " to " + c.New + " (changes " + pkgName(d, defs[0]) + "." + gotpl.CamelName(defs[0]) + " signature)"
+	}
+	return BumpPatch, c.Attr + " changed from " + c.Old + " to " + c.New
+}
+
+// resolveChange returns the domain and the item and member definitions for
+// the change's path in pdl. Any missing definitions are returned as nil.
+func resolveChange(p *pdl.PDL, c pdl.Change) (*pdl.Domain, []*pdl.Type) {
+	n := strings.Split(c.Path, ".")
+
+	var d *pdl.Domain
+	for _, z := range p.Domains {
+		if z.Domain.String() == n[0] {
+			d = z
+			break
+		}
+	}
+	defs := make([]*pdl.Type, len(n)-1)
+	if d == nil || len(defs) == 0 {
+		return d, defs
+	}
+
+	// item
+	kind := c.Item
+	if kind == "" {
+		kind = c.Element
+	}
+	var typs []*pdl.Type
+	switch kind {
+	case "type":
+		typs = d.Types
+	case "command":
+		typs = d.Commands
+	case "event":
+		typs = d.Events
+	}
+	defs[0] = findType(typs, n[1])
+	if defs[0] == nil || len(defs) == 1 {
+		return d, defs
+	}
+
+	// member
+	var lists [][]*pdl.Type
+	switch c.Element {
+	case "property":
+		lists = [][]*pdl.Type{defs[0].Properties}
+	case "parameter":
+		lists = [][]*pdl.Type{defs[0].Parameters}
+	case "return value":
+		lists = [][]*pdl.Type{defs[0].Returns}
+	default:
+		lists = [][]*pdl.Type{defs[0].Parameters, defs[0].Returns, defs[0].Properties}
+	}
+	for _, typs := range lists {
+		if defs[1] = findType(typs, n[2]); defs[1] != nil {
+			break
+		}
+	}
+	return d, defs
+}
+
+// emitted determines if a Go definition is generated for the domain, item,
+// and member definitions.
+func emitted(d *pdl.Domain, defs []*pdl.Type) bool {
+	if d == nil || d.Deprecated {
+		return false
+	}
+	for _, t := range defs {
+		if t == nil || ((t.Deprecated || t.Redirect != nil) && !t.AlwaysEmit) {
+			return false
+		}
+	}
+	return true
+}
+
+// changeIdent returns the Go identifier for the changed element.
+func changeIdent(c pdl.Change, d *pdl.Domain, defs []*pdl.Type) string {
+	if d == nil {
+		return strings.ToLower(strings.SplitN(c.Path, ".", 2)[0])
+	}
+	if len(defs) == 0 {
+		return genutil.PackageName(d)
+	}
+
+	item := defs[0]
+	pkg := pkgName(d, item)
+
+	// enum values
+	if c.Element == "enum value" {
+		v := c.New
+		if c.Kind == pdl.ChangeRemoved {
+			v = c.Old
+		}
+		typ := item
+		if len(defs) == 2 && defs[1] != nil {
+			typ = &pdl.Type{Name: gotpl.CamelName(&pdl.Type{Name: item.Name + "." + defs[1].Name})}
+		}
+		return pkg + "." + gotpl.EnumValueName(typ, v)
+	}
+
+	var name string
+	switch {
+	case c.Item == "command" && c.Element == "return value":
+		name = gotpl.CommandReturnsType(item)
+	case c.Item == "command" || c.Element == "command":
+		name = gotpl.CommandType(item)
+	case c.Item == "event" || c.Element == "event":
+		name = gotpl.EventType(item)
+	default:
+		name = gotpl.CamelName(item)
+	}
+	if len(defs) == 2 && defs[1] != nil {
+		name += "." + gotpl.GoName(defs[1], false)
+	}
+	return pkg + "." + name
+}
+
+// pkgName returns the Go package name for an item in domain d.
+func pkgName(d *pdl.Domain, item *pdl.Type) string {
+	if item != nil && item.IsCircularDep {
+		return "cdp"
+	}
+	return genutil.PackageName(d)
+}
+
+// commandDo returns the Go identifier for a command's Do func.
+func commandDo(d *pdl.Domain, item *pdl.Type) string {
+	return pkgName(d, item) + ".(*" + gotpl.CommandType(item) + ").Do"
+}
+
+// findType returns the type with name, or nil if not found.
+func findType(typs []*pdl.Type, name string) *pdl.Type {
+	for _, t := range typs {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
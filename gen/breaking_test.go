@@ -0,0 +1,227 @@
+package gen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/chromedp/cdproto-gen/pdl"
+)
+
+// breakingBase is the protocol definition the changes in TestClassifyChanges
+// are made to.
+const breakingBase = `domain Page
+  type FrameId extends string
+
+  type Kind extends string
+    enum
+      plain
+      fancy
+
+  type Frame extends object
+    properties
+      FrameId id
+      optional string url
+
+  command navigate
+    parameters
+      string url
+      optional string referrer
+    returns
+      FrameId frameId
+
+  command reload
+
+  event loaded
+    parameters
+      number timestamp
+
+  deprecated command old
+    parameters
+      string a
+`
+
+func TestClassifyChanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		exp      []APIChange
+		bump     Bump
+	}{
+		{"no change", "", "", nil, BumpNone},
+		{
+			"experimental changed",
+			"  command reload", "  experimental command reload",
+			[]APIChange{{Ident: "page.ReloadParams", Bump: BumpPatch, Reason: "experimental changed from false to true"}},
+			BumpPatch,
+		},
+		{
+			"optional property changed",
+			"      optional string url", "      string url",
+			[]APIChange{{Ident: "cdp.Frame.URL", Bump: BumpPatch, Reason: "optional changed from true to false"}},
+			BumpPatch,
+		},
+		{
+			"enum value added",
+			"      fancy\n", "      fancy\n      kebab-case\n",
+			[]APIChange{{Ident: "page.KindKebabCase", Bump: BumpMinor, Reason: "enum value added"}},
+			BumpMinor,
+		},
+		{
+			"enum value removed",
+			"      plain\n      fancy\n", "      plain\n",
+			[]APIChange{{Ident: "page.KindFancy", Bump: BumpMajor, Reason: "enum value removed"}},
+			BumpMajor,
+		},
+		{
+			"type added",
+			"  command reload\n", "  command reload\n\n  type Extra extends integer\n",
+			[]APIChange{{Ident: "page.Extra", Bump: BumpMinor, Reason: "type added"}},
+			BumpMinor,
+		},
+		{
+			"type removed",
+			"  type FrameId extends string\n", "",
+			// the reference to the removed type is changed as well
+			[]APIChange{{Ident: "cdp.FrameID", Bump: BumpMajor, Reason: "type removed"}},
+			BumpMajor,
+		},
+		{
+			"optional parameter added",
+			"      optional string referrer\n", "      optional string referrer\n      optional boolean force\n",
+			[]APIChange{{Ident: "page.NavigateParams.Force", Bump: BumpMinor, Reason: "parameter added"}},
+			BumpMinor,
+		},
+		{
+			"required parameter added",
+			"      optional string referrer\n", "      optional string referrer\n      boolean force\n",
+			[]APIChange{{Ident: "page.NavigateParams.Force", Bump: BumpMajor, Reason: "required parameter added to page.Navigate"}},
+			BumpMajor,
+		},
+		{
+			"parameter removed",
+			"      optional string referrer\n", "",
+			[]APIChange{{Ident: "page.NavigateParams.Referrer", Bump: BumpMajor, Reason: "parameter removed"}},
+			BumpMajor,
+		},
+		{
+			"parameter optional changed",
+			"      optional string referrer\n", "      string referrer\n",
+			[]APIChange{{Ident: "page.NavigateParams.Referrer", Bump: BumpMajor, Reason: "parameter optional changed from true to false (changes page.Navigate signature)"}},
+			BumpMajor,
+		},
+		{
+			"return value added",
+			"      FrameId frameId\n", "      FrameId frameId\n      string loaderId\n",
+			[]APIChange{{Ident: "page.NavigateReturns.LoaderID", Bump: BumpMajor, Reason: "return value added (changes page.(*NavigateParams).Do signature)"}},
+			BumpMajor,
+		},
+		{
+			"return value removed",
+			"    returns\n      FrameId frameId\n", "",
+			[]APIChange{{Ident: "page.NavigateReturns.FrameID", Bump: BumpMajor, Reason: "return value removed (changes page.(*NavigateParams).Do signature)"}},
+			BumpMajor,
+		},
+		{
+			"property type changed",
+			"      optional string url\n", "      optional integer url\n",
+			[]APIChange{{Ident: "cdp.Frame.URL", Bump: BumpMajor, Reason: "type changed from string to integer"}},
+			BumpMajor,
+		},
+		{
+			"event added",
+			"  event loaded\n", "  event unloaded\n\n  event loaded\n",
+			[]APIChange{{Ident: "page.EventUnloaded", Bump: BumpMinor, Reason: "event added"}},
+			BumpMinor,
+		},
+		{
+			"command removed",
+			"  command reload\n", "",
+			[]APIChange{{Ident: "page.ReloadParams", Bump: BumpMajor, Reason: "command removed"}},
+			BumpMajor,
+		},
+		{
+			"command deprecated",
+			"  command reload\n", "  deprecated command reload\n",
+			[]APIChange{{Ident: "page.ReloadParams", Bump: BumpMajor, Reason: "command deprecated (no longer generated)"}},
+			BumpMajor,
+		},
+		{
+			"command redirected",
+			"  command reload\n", "  command reload\n    redirect Other\n",
+			[]APIChange{{Ident: "page.ReloadParams", Bump: BumpMajor, Reason: "command redirected to Other (no longer generated)"}},
+			BumpMajor,
+		},
+		{
+			"deprecated command changed",
+			"      string a\n", "      string b\n",
+			nil,
+			BumpNone,
+		},
+		{
+			"domain added",
+			"      string a\n", "      string a\n\ndomain Other\n",
+			[]APIChange{{Ident: "other", Bump: BumpMinor, Reason: "domain added"}},
+			BumpMinor,
+		},
+		{
+			"domain deprecated",
+			"domain Page\n", "deprecated domain Page\n",
+			[]APIChange{{Ident: "page", Bump: BumpMajor, Reason: "domain deprecated (no longer generated)"}},
+			BumpMajor,
+		},
+	}
+	old, err := pdl.Parse([]byte(breakingBase))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = pdl.ResolveCircularDeps(old.Domains, pdl.CircularDepOverrides); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if !strings.Contains(breakingBase, test.old) {
+				t.Fatalf("base does not contain %q", test.old)
+			}
+			p, err := pdl.Parse([]byte(strings.Replace(breakingBase, test.old, test.new, 1)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err = pdl.ResolveCircularDeps(p.Domains, pdl.CircularDepOverrides); err != nil {
+				t.Fatal(err)
+			}
+			changes := ClassifyChanges(pdl.Diff(old, p))
+			if len(changes) != len(test.exp) {
+				t.Fatalf("expected %d changes, got: %v", len(test.exp), changes)
+			}
+			for i, c := range changes {
+				if c.Ident != test.exp[i].Ident || c.Bump != test.exp[i].Bump || c.Reason != test.exp[i].Reason {
+					t.Errorf("change %d expected %v, got: %v", i, test.exp[i], c)
+				}
+			}
+			if bump := SuggestBump(changes); bump != test.bump {
+				t.Errorf("expected bump %s, got: %s", test.bump, bump)
+			}
+		})
+	}
+}
+
+func TestSuggestBump(t *testing.T) {
+	tests := []struct {
+		bumps []Bump
+		exp   Bump
+	}{
+		{nil, BumpNone},
+		{[]Bump{BumpPatch}, BumpPatch},
+		{[]Bump{BumpPatch, BumpMinor, BumpPatch}, BumpMinor},
+		{[]Bump{BumpMinor, BumpMajor, BumpPatch}, BumpMajor},
+	}
+	for i, test := range tests {
+		var changes []APIChange
+		for _, b := range test.bumps {
+			changes = append(changes, APIChange{Bump: b})
+		}
+		if bump := SuggestBump(changes); bump != test.exp {
+			t.Errorf("test %d expected %s, got: %s", i, test.exp, bump)
+		}
+	}
+}
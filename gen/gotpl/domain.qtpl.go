@@ -507,136 +507,146 @@ func StreamCommandDoFuncTemplate(qw422016 *qt422016.Writer, c *pdl.Type, d *pdl.
 //line gen/gotpl/domain.qtpl:122
 	}
 //line gen/gotpl/domain.qtpl:122
+	if c.Supported != "" {
+//line gen/gotpl/domain.qtpl:122
+		qw422016.N().S(`
+//
+// Supported: `)
+//line gen/gotpl/domain.qtpl:124
+		qw422016.N().S(c.Supported)
+//line gen/gotpl/domain.qtpl:124
+	}
+//line gen/gotpl/domain.qtpl:124
 	qw422016.N().S(`
 func (p *`)
-//line gen/gotpl/domain.qtpl:123
+//line gen/gotpl/domain.qtpl:125
 	qw422016.N().S(typ)
-//line gen/gotpl/domain.qtpl:123
+//line gen/gotpl/domain.qtpl:125
 	qw422016.N().S(`) Do(ctx context.Context) (`)
-//line gen/gotpl/domain.qtpl:123
+//line gen/gotpl/domain.qtpl:125
 	qw422016.N().S(retTypeList)
-//line gen/gotpl/domain.qtpl:123
+//line gen/gotpl/domain.qtpl:125
 	qw422016.N().S(`err error) {`)
-//line gen/gotpl/domain.qtpl:123
+//line gen/gotpl/domain.qtpl:125
 	if hasEmptyRet {
-//line gen/gotpl/domain.qtpl:123
+//line gen/gotpl/domain.qtpl:125
 		qw422016.N().S(`
 	return cdp.Execute(ctx, `)
-//line gen/gotpl/domain.qtpl:124
+//line gen/gotpl/domain.qtpl:126
 		qw422016.N().S(CommandMethodType(c, nil))
-//line gen/gotpl/domain.qtpl:124
+//line gen/gotpl/domain.qtpl:126
 		qw422016.N().S(`, `)
-//line gen/gotpl/domain.qtpl:124
+//line gen/gotpl/domain.qtpl:126
 		qw422016.N().S(pval)
-//line gen/gotpl/domain.qtpl:124
+//line gen/gotpl/domain.qtpl:126
 		qw422016.N().S(`, nil)`)
-//line gen/gotpl/domain.qtpl:124
+//line gen/gotpl/domain.qtpl:126
 	} else {
-//line gen/gotpl/domain.qtpl:124
+//line gen/gotpl/domain.qtpl:126
 		qw422016.N().S(`
 	// execute
 	var res `)
-//line gen/gotpl/domain.qtpl:126
+//line gen/gotpl/domain.qtpl:128
 		qw422016.N().S(CommandReturnsType(c))
-//line gen/gotpl/domain.qtpl:126
+//line gen/gotpl/domain.qtpl:128
 		qw422016.N().S(`
 	err = cdp.Execute(ctx, `)
-//line gen/gotpl/domain.qtpl:127
+//line gen/gotpl/domain.qtpl:129
 		qw422016.N().S(CommandMethodType(c, nil))
-//line gen/gotpl/domain.qtpl:127
+//line gen/gotpl/domain.qtpl:129
 		qw422016.N().S(`, `)
-//line gen/gotpl/domain.qtpl:127
+//line gen/gotpl/domain.qtpl:129
 		qw422016.N().S(pval)
-//line gen/gotpl/domain.qtpl:127
+//line gen/gotpl/domain.qtpl:129
 		qw422016.N().S(`, &res)
 	if err != nil {
 		return `)
-//line gen/gotpl/domain.qtpl:129
+//line gen/gotpl/domain.qtpl:131
 		qw422016.N().S(emptyRet)
-//line gen/gotpl/domain.qtpl:129
+//line gen/gotpl/domain.qtpl:131
 		qw422016.N().S(`err
 	}
 	`)
-//line gen/gotpl/domain.qtpl:131
+//line gen/gotpl/domain.qtpl:133
 		if b64ret != nil {
-//line gen/gotpl/domain.qtpl:131
+//line gen/gotpl/domain.qtpl:133
 			qw422016.N().S(`
 	// decode
 	var dec []byte`)
-//line gen/gotpl/domain.qtpl:133
+//line gen/gotpl/domain.qtpl:135
 			if b64cond {
-//line gen/gotpl/domain.qtpl:133
+//line gen/gotpl/domain.qtpl:135
 				qw422016.N().S(`
 	if res.Base64encoded {`)
-//line gen/gotpl/domain.qtpl:134
+//line gen/gotpl/domain.qtpl:136
 			}
-//line gen/gotpl/domain.qtpl:134
+//line gen/gotpl/domain.qtpl:136
 			qw422016.N().S(`
 		dec, err = base64.StdEncoding.DecodeString(res.`)
-//line gen/gotpl/domain.qtpl:135
+//line gen/gotpl/domain.qtpl:137
 			qw422016.N().S(GoName(b64ret, false))
-//line gen/gotpl/domain.qtpl:135
+//line gen/gotpl/domain.qtpl:137
 			qw422016.N().S(`)
 		if err != nil {
 			return `)
-//line gen/gotpl/domain.qtpl:137
+//line gen/gotpl/domain.qtpl:139
 			qw422016.N().S(emptyRet)
-//line gen/gotpl/domain.qtpl:137
+//line gen/gotpl/domain.qtpl:139
 			qw422016.N().S(`err
 		}`)
-//line gen/gotpl/domain.qtpl:138
+//line gen/gotpl/domain.qtpl:140
 			if b64cond {
-//line gen/gotpl/domain.qtpl:138
+//line gen/gotpl/domain.qtpl:140
 				qw422016.N().S(`
 	} else {
 		dec = []byte(res.`)
-//line gen/gotpl/domain.qtpl:140
+//line gen/gotpl/domain.qtpl:142
 				qw422016.N().S(GoName(b64ret, false))
-//line gen/gotpl/domain.qtpl:140
+//line gen/gotpl/domain.qtpl:142
 				qw422016.N().S(`)
 	}`)
-//line gen/gotpl/domain.qtpl:141
+//line gen/gotpl/domain.qtpl:143
 			}
-//line gen/gotpl/domain.qtpl:141
+//line gen/gotpl/domain.qtpl:143
 		}
-//line gen/gotpl/domain.qtpl:141
+//line gen/gotpl/domain.qtpl:143
 		qw422016.N().S(`
 	return `)
-//line gen/gotpl/domain.qtpl:142
+//line gen/gotpl/domain.qtpl:144
 		qw422016.N().S(retValueList)
-//line gen/gotpl/domain.qtpl:142
+//line gen/gotpl/domain.qtpl:144
 		qw422016.N().S(`nil`)
-//line gen/gotpl/domain.qtpl:142
+//line gen/gotpl/domain.qtpl:144
 	}
-//line gen/gotpl/domain.qtpl:142
+//line gen/gotpl/domain.qtpl:144
 	qw422016.N().S(`
 }
 `)
-//line gen/gotpl/domain.qtpl:144
+//line gen/gotpl/domain.qtpl:146
 }
 
-//line gen/gotpl/domain.qtpl:144
+//line gen/gotpl/domain.qtpl:146
 func WriteCommandDoFuncTemplate(qq422016 qtio422016.Writer, c *pdl.Type, d *pdl.Domain, domains []*pdl.Domain) {
-//line gen/gotpl/domain.qtpl:144
+//line gen/gotpl/domain.qtpl:146
 	qw422016 := qt422016.AcquireWriter(qq422016)
-//line gen/gotpl/domain.qtpl:144
+//line gen/gotpl/domain.qtpl:146
 	StreamCommandDoFuncTemplate(qw422016, c, d, domains)
-//line gen/gotpl/domain.qtpl:144
+//line gen/gotpl/domain.qtpl:146
 	qt422016.ReleaseWriter(qw422016)
-//line gen/gotpl/domain.qtpl:144
+//line gen/gotpl/domain.qtpl:146
 }
 
-//line gen/gotpl/domain.qtpl:144
+//line gen/gotpl/domain.qtpl:146
 func CommandDoFuncTemplate(c *pdl.Type, d *pdl.Domain, domains []*pdl.Domain) string {
-//line gen/gotpl/domain.qtpl:144
+//line gen/gotpl/domain.qtpl:146
 	qb422016 := qt422016.AcquireByteBuffer()
-//line gen/gotpl/domain.qtpl:144
+//line gen/gotpl/domain.qtpl:146
 	WriteCommandDoFuncTemplate(qb422016, c, d, domains)
-//line gen/gotpl/domain.qtpl:144
+//line gen/gotpl/domain.qtpl:146
 	qs422016 := string(qb422016.B)
-//line gen/gotpl/domain.qtpl:144
+//line gen/gotpl/domain.qtpl:146
 	qt422016.ReleaseByteBuffer(qb422016)
-//line gen/gotpl/domain.qtpl:144
+//line gen/gotpl/domain.qtpl:146
 	return qs422016
-//line gen/gotpl/domain.qtpl:144
+//line gen/gotpl/domain.qtpl:146
 }
@@ -57,20 +57,30 @@ func StreamTypeTemplate(qw422016 *qt422016.Writer, t *pdl.Type, prefix, suffix s
 //line gen/gotpl/type.qtpl:23
 	}
 //line gen/gotpl/type.qtpl:23
+	if t.RawType == "event" && t.Supported != "" {
+//line gen/gotpl/type.qtpl:23
+		qw422016.N().S(`
+//
+// Supported: `)
+//line gen/gotpl/type.qtpl:25
+		qw422016.N().S(t.Supported)
+//line gen/gotpl/type.qtpl:25
+	}
+//line gen/gotpl/type.qtpl:25
 	qw422016.N().S(`
 type `)
-//line gen/gotpl/type.qtpl:24
+//line gen/gotpl/type.qtpl:26
 	qw422016.N().S(typ)
-//line gen/gotpl/type.qtpl:24
+//line gen/gotpl/type.qtpl:26
 	qw422016.N().S(` `)
-//line gen/gotpl/type.qtpl:24
+//line gen/gotpl/type.qtpl:26
 	qw422016.N().S(GoTypeDef(t, d, domains, extra, noExposeOverride, omitOnlyWhenOptional))
-//line gen/gotpl/type.qtpl:24
+//line gen/gotpl/type.qtpl:26
 	qw422016.N().S(`
 `)
-//line gen/gotpl/type.qtpl:25
+//line gen/gotpl/type.qtpl:27
 	if t.Parameters == nil && t.Type != pdl.TypeArray && t.Type != pdl.TypeObject && t.Type != pdl.TypeAny {
-//line gen/gotpl/type.qtpl:26
+//line gen/gotpl/type.qtpl:28
 		gz := GoEnumType(t.Type)
 		z := gz
 		if strings.Contains(z, ".") {
@@ -78,49 +88,49 @@ type `)
 		}
 		z = strings.ToUpper(z[:1]) + z[1:]
 
-//line gen/gotpl/type.qtpl:32
+//line gen/gotpl/type.qtpl:34
 		qw422016.N().S(`
 // `)
-//line gen/gotpl/type.qtpl:33
+//line gen/gotpl/type.qtpl:35
 		qw422016.N().S(z)
-//line gen/gotpl/type.qtpl:33
+//line gen/gotpl/type.qtpl:35
 		qw422016.N().S(` returns the `)
-//line gen/gotpl/type.qtpl:33
+//line gen/gotpl/type.qtpl:35
 		qw422016.N().S(typ)
-//line gen/gotpl/type.qtpl:33
+//line gen/gotpl/type.qtpl:35
 		qw422016.N().S(` as `)
-//line gen/gotpl/type.qtpl:33
+//line gen/gotpl/type.qtpl:35
 		qw422016.N().S(gz)
-//line gen/gotpl/type.qtpl:33
+//line gen/gotpl/type.qtpl:35
 		qw422016.N().S(` value.
 func (t `)
-//line gen/gotpl/type.qtpl:34
+//line gen/gotpl/type.qtpl:36
 		qw422016.N().S(typ)
-//line gen/gotpl/type.qtpl:34
+//line gen/gotpl/type.qtpl:36
 		qw422016.N().S(`) `)
-//line gen/gotpl/type.qtpl:34
+//line gen/gotpl/type.qtpl:36
 		qw422016.N().S(z)
-//line gen/gotpl/type.qtpl:34
+//line gen/gotpl/type.qtpl:36
 		qw422016.N().S(`() `)
-//line gen/gotpl/type.qtpl:34
+//line gen/gotpl/type.qtpl:36
 		qw422016.N().S(gz)
-//line gen/gotpl/type.qtpl:34
+//line gen/gotpl/type.qtpl:36
 		qw422016.N().S(` {
 	return `)
-//line gen/gotpl/type.qtpl:35
+//line gen/gotpl/type.qtpl:37
 		qw422016.N().S(gz)
-//line gen/gotpl/type.qtpl:35
+//line gen/gotpl/type.qtpl:37
 		qw422016.N().S(`(t)
 }
 `)
-//line gen/gotpl/type.qtpl:37
+//line gen/gotpl/type.qtpl:39
 	}
-//line gen/gotpl/type.qtpl:37
+//line gen/gotpl/type.qtpl:39
 	qw422016.N().S(`
 `)
-//line gen/gotpl/type.qtpl:38
+//line gen/gotpl/type.qtpl:40
 	if ev := t.Enum; ev != nil {
-//line gen/gotpl/type.qtpl:39
+//line gen/gotpl/type.qtpl:41
 		gz := GoEnumType(t.Type)
 		z := gz
 		if strings.Contains(z, ".") {
@@ -128,16 +138,16 @@ func (t `)
 		}
 		z = strings.ToUpper(z[:1]) + z[1:]
 
-//line gen/gotpl/type.qtpl:45
+//line gen/gotpl/type.qtpl:47
 		qw422016.N().S(`// `)
-//line gen/gotpl/type.qtpl:45
+//line gen/gotpl/type.qtpl:47
 		qw422016.N().S(typ)
-//line gen/gotpl/type.qtpl:45
+//line gen/gotpl/type.qtpl:47
 		qw422016.N().S(` values.
 const (`)
-//line gen/gotpl/type.qtpl:46
+//line gen/gotpl/type.qtpl:48
 		for i, e := range ev {
-//line gen/gotpl/type.qtpl:47
+//line gen/gotpl/type.qtpl:49
 			n := EnumValueName(t, e)
 			val := `"` + e + `"`
 			if t.Type == pdl.TypeInteger && t.EnumBitMask {
@@ -146,190 +156,190 @@ const (`)
 				val = strconv.Itoa(i + 1)
 			}
 
-//line gen/gotpl/type.qtpl:54
+//line gen/gotpl/type.qtpl:56
 			qw422016.N().S(`
 	`)
-//line gen/gotpl/type.qtpl:55
+//line gen/gotpl/type.qtpl:57
 			qw422016.N().S(n)
-//line gen/gotpl/type.qtpl:55
+//line gen/gotpl/type.qtpl:57
 			qw422016.N().S(` `)
-//line gen/gotpl/type.qtpl:55
+//line gen/gotpl/type.qtpl:57
 			qw422016.N().S(typ)
-//line gen/gotpl/type.qtpl:55
+//line gen/gotpl/type.qtpl:57
 			qw422016.N().S(` = `)
-//line gen/gotpl/type.qtpl:55
+//line gen/gotpl/type.qtpl:57
 			qw422016.N().S(val)
-//line gen/gotpl/type.qtpl:55
+//line gen/gotpl/type.qtpl:57
 		}
-//line gen/gotpl/type.qtpl:55
+//line gen/gotpl/type.qtpl:57
 		qw422016.N().S(`
 )
 `)
-//line gen/gotpl/type.qtpl:57
+//line gen/gotpl/type.qtpl:59
 		if t.Type != pdl.TypeString {
-//line gen/gotpl/type.qtpl:57
+//line gen/gotpl/type.qtpl:59
 			qw422016.N().S(`
 // String returns the `)
-//line gen/gotpl/type.qtpl:58
+//line gen/gotpl/type.qtpl:60
 			qw422016.N().S(typ)
-//line gen/gotpl/type.qtpl:58
+//line gen/gotpl/type.qtpl:60
 			qw422016.N().S(` as string value.
 func (t `)
-//line gen/gotpl/type.qtpl:59
+//line gen/gotpl/type.qtpl:61
 			qw422016.N().S(typ)
-//line gen/gotpl/type.qtpl:59
+//line gen/gotpl/type.qtpl:61
 			qw422016.N().S(`) String() string {
 	switch t {`)
-//line gen/gotpl/type.qtpl:60
+//line gen/gotpl/type.qtpl:62
 			for _, e := range t.Enum {
-//line gen/gotpl/type.qtpl:60
+//line gen/gotpl/type.qtpl:62
 				qw422016.N().S(`
 	case `)
-//line gen/gotpl/type.qtpl:61
+//line gen/gotpl/type.qtpl:63
 				qw422016.N().S(EnumValueName(t, e))
-//line gen/gotpl/type.qtpl:61
+//line gen/gotpl/type.qtpl:63
 				qw422016.N().S(`:
 		return `)
-//line gen/gotpl/type.qtpl:62
+//line gen/gotpl/type.qtpl:64
 				qw422016.N().Q(e)
-//line gen/gotpl/type.qtpl:62
+//line gen/gotpl/type.qtpl:64
 			}
-//line gen/gotpl/type.qtpl:62
+//line gen/gotpl/type.qtpl:64
 			qw422016.N().S(`
 	}
 
 	return fmt.Sprintf("`)
-//line gen/gotpl/type.qtpl:65
+//line gen/gotpl/type.qtpl:67
 			qw422016.N().S(typ)
-//line gen/gotpl/type.qtpl:65
+//line gen/gotpl/type.qtpl:67
 			qw422016.N().S(`(%d)", t)
 }
 `)
-//line gen/gotpl/type.qtpl:67
+//line gen/gotpl/type.qtpl:69
 		}
-//line gen/gotpl/type.qtpl:67
+//line gen/gotpl/type.qtpl:69
 		qw422016.N().S(`
 
 // MarshalEasyJSON satisfies easyjson.Marshaler.
 func (t `)
-//line gen/gotpl/type.qtpl:70
+//line gen/gotpl/type.qtpl:72
 		qw422016.N().S(typ)
-//line gen/gotpl/type.qtpl:70
+//line gen/gotpl/type.qtpl:72
 		qw422016.N().S(`) MarshalEasyJSON(out *jwriter.Writer) {
 	out.`)
-//line gen/gotpl/type.qtpl:71
+//line gen/gotpl/type.qtpl:73
 		qw422016.N().S(z)
-//line gen/gotpl/type.qtpl:71
+//line gen/gotpl/type.qtpl:73
 		qw422016.N().S(`(`)
-//line gen/gotpl/type.qtpl:71
+//line gen/gotpl/type.qtpl:73
 		qw422016.N().S(gz)
-//line gen/gotpl/type.qtpl:71
+//line gen/gotpl/type.qtpl:73
 		qw422016.N().S(`(t))
 }
 
 // MarshalJSON satisfies json.Marshaler.
 func (t `)
-//line gen/gotpl/type.qtpl:75
+//line gen/gotpl/type.qtpl:77
 		qw422016.N().S(typ)
-//line gen/gotpl/type.qtpl:75
+//line gen/gotpl/type.qtpl:77
 		qw422016.N().S(`) MarshalJSON() ([]byte, error) {
 	return easyjson.Marshal(t)
 }
 
 // UnmarshalEasyJSON satisfies easyjson.Unmarshaler.
 func (t *`)
-//line gen/gotpl/type.qtpl:80
+//line gen/gotpl/type.qtpl:82
 		qw422016.N().S(typ)
-//line gen/gotpl/type.qtpl:80
+//line gen/gotpl/type.qtpl:82
 		qw422016.N().S(`) UnmarshalEasyJSON(in *jlexer.Lexer) {
 	switch `)
-//line gen/gotpl/type.qtpl:81
+//line gen/gotpl/type.qtpl:83
 		qw422016.N().S(typ)
-//line gen/gotpl/type.qtpl:81
+//line gen/gotpl/type.qtpl:83
 		qw422016.N().S(`(in.`)
-//line gen/gotpl/type.qtpl:81
+//line gen/gotpl/type.qtpl:83
 		qw422016.N().S(z)
-//line gen/gotpl/type.qtpl:81
+//line gen/gotpl/type.qtpl:83
 		qw422016.N().S(`()) {`)
-//line gen/gotpl/type.qtpl:81
+//line gen/gotpl/type.qtpl:83
 		for _, e := range t.Enum {
-//line gen/gotpl/type.qtpl:82
+//line gen/gotpl/type.qtpl:84
 			n := EnumValueName(t, e)
 
-//line gen/gotpl/type.qtpl:83
+//line gen/gotpl/type.qtpl:85
 			qw422016.N().S(`
 	case `)
-//line gen/gotpl/type.qtpl:84
+//line gen/gotpl/type.qtpl:86
 			qw422016.N().S(n)
-//line gen/gotpl/type.qtpl:84
+//line gen/gotpl/type.qtpl:86
 			qw422016.N().S(`:
 		*t = `)
-//line gen/gotpl/type.qtpl:85
+//line gen/gotpl/type.qtpl:87
 			qw422016.N().S(n)
-//line gen/gotpl/type.qtpl:85
+//line gen/gotpl/type.qtpl:87
 		}
-//line gen/gotpl/type.qtpl:85
+//line gen/gotpl/type.qtpl:87
 		qw422016.N().S(`
 
 	default:
 		in.AddError(errors.New("unknown `)
-//line gen/gotpl/type.qtpl:88
+//line gen/gotpl/type.qtpl:90
 		qw422016.N().S(typ)
-//line gen/gotpl/type.qtpl:88
+//line gen/gotpl/type.qtpl:90
 		qw422016.N().S(` value"))
 	}
 }
 
 // UnmarshalJSON satisfies json.Unmarshaler.
 func (t *`)
-//line gen/gotpl/type.qtpl:93
+//line gen/gotpl/type.qtpl:95
 		qw422016.N().S(typ)
-//line gen/gotpl/type.qtpl:93
+//line gen/gotpl/type.qtpl:95
 		qw422016.N().S(`) UnmarshalJSON(buf []byte) error {
 	return easyjson.Unmarshal(buf, t)
 }`)
-//line gen/gotpl/type.qtpl:95
+//line gen/gotpl/type.qtpl:97
 	}
-//line gen/gotpl/type.qtpl:95
+//line gen/gotpl/type.qtpl:97
 	qw422016.N().S(`
 `)
-//line gen/gotpl/type.qtpl:96
+//line gen/gotpl/type.qtpl:98
 	if t.Extra != "" {
-//line gen/gotpl/type.qtpl:96
+//line gen/gotpl/type.qtpl:98
 		qw422016.N().S(`
 `)
-//line gen/gotpl/type.qtpl:97
+//line gen/gotpl/type.qtpl:99
 		qw422016.N().S(t.Extra)
-//line gen/gotpl/type.qtpl:97
+//line gen/gotpl/type.qtpl:99
 	}
-//line gen/gotpl/type.qtpl:97
+//line gen/gotpl/type.qtpl:99
 	qw422016.N().S(`
 `)
-//line gen/gotpl/type.qtpl:98
+//line gen/gotpl/type.qtpl:100
 }
 
-//line gen/gotpl/type.qtpl:98
+//line gen/gotpl/type.qtpl:100
 func WriteTypeTemplate(qq422016 qtio422016.Writer, t *pdl.Type, prefix, suffix string, d *pdl.Domain, domains []*pdl.Domain, v interface{}, noExposeOverride, omitOnlyWhenOptional bool) {
-//line gen/gotpl/type.qtpl:98
+//line gen/gotpl/type.qtpl:100
 	qw422016 := qt422016.AcquireWriter(qq422016)
-//line gen/gotpl/type.qtpl:98
+//line gen/gotpl/type.qtpl:100
 	StreamTypeTemplate(qw422016, t, prefix, suffix, d, domains, v, noExposeOverride, omitOnlyWhenOptional)
-//line gen/gotpl/type.qtpl:98
+//line gen/gotpl/type.qtpl:100
 	qt422016.ReleaseWriter(qw422016)
-//line gen/gotpl/type.qtpl:98
+//line gen/gotpl/type.qtpl:100
 }
 
-//line gen/gotpl/type.qtpl:98
+//line gen/gotpl/type.qtpl:100
 func TypeTemplate(t *pdl.Type, prefix, suffix string, d *pdl.Domain, domains []*pdl.Domain, v interface{}, noExposeOverride, omitOnlyWhenOptional bool) string {
-//line gen/gotpl/type.qtpl:98
+//line gen/gotpl/type.qtpl:100
 	qb422016 := qt422016.AcquireByteBuffer()
-//line gen/gotpl/type.qtpl:98
+//line gen/gotpl/type.qtpl:100
 	WriteTypeTemplate(qb422016, t, prefix, suffix, d, domains, v, noExposeOverride, omitOnlyWhenOptional)
-//line gen/gotpl/type.qtpl:98
+//line gen/gotpl/type.qtpl:100
 	qs422016 := string(qb422016.B)
-//line gen/gotpl/type.qtpl:98
+//line gen/gotpl/type.qtpl:100
 	qt422016.ReleaseByteBuffer(qb422016)
-//line gen/gotpl/type.qtpl:98
+//line gen/gotpl/type.qtpl:100
 	return qs422016
-//line gen/gotpl/type.qtpl:98
+//line gen/gotpl/type.qtpl:100
 }
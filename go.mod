@@ -1,7 +1,6 @@
 module github.com/chromedp/cdproto-gen
 
 require (
-	github.com/Masterminds/semver v1.5.0
 	github.com/PuerkitoBio/goquery v1.5.1
 	github.com/andybalholm/cascadia v1.2.0 // indirect
 	github.com/client9/misspell v0.3.4
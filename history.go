@@ -0,0 +1,683 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/chromedp/cdproto-gen/pdl"
+	"github.com/chromedp/cdproto-gen/util"
+)
+
+// historyUsage is the usage of the history command.
+const historyUsage = `usage: %s history [-cache dir] <command> [args]
+
+Commands:
+  fetch [flags]          retrieve and combine the protocol definitions of past
+                         chromium versions (see history fetch -h)
+  index                  index the versions in which each element appears
+  query [flags] <path>   display the index entries of an element (ie,
+                         Network.getCookies) as json, or with -bisect, find
+                         the version in which it last changed, retrieving
+                         only the versions needed (see history query -h)
+`
+
+// historyFile and indexFile are the names of the history manifest and the
+// history index, written to the combined protocol definitions directory.
+const (
+	historyFile = "history.json"
+	indexFile   = "index.json"
+)
+
+// runHistory runs the history command, managing the combined protocol
+// definitions of past chromium versions.
+func runHistory(args []string) error {
+	fs := flag.NewFlagSet("history", flag.ExitOnError)
+	fs.StringVar(flagCache, "cache", "", "protocol cache directory")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), historyUsage, os.Args[0])
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if err := setCache(); err != nil {
+		return err
+	}
+
+	cmd, args := fs.Arg(0), fs.Args()[1:]
+	switch cmd {
+	case "fetch":
+		return historyFetch(args)
+	case "index":
+		return historyIndex(args)
+	case "query":
+		return historyQuery(args)
+	}
+	return fmt.Errorf("unknown history command %q", cmd)
+}
+
+// History is the history manifest, listing the combined protocol definitions
+// files written by history fetch.
+type History struct {
+	// Versions are the chromium versions, sorted.
+	Versions []HistoryVersion `json:"versions"`
+}
+
+// HistoryVersion is a chromium version in the history manifest.
+type HistoryVersion struct {
+	// Chromium and V8 are the protocol versions. V8 is a revision when no
+	// release version is tagged at the revision.
+	Chromium string `json:"chromium"`
+	V8       string `json:"v8,omitempty"`
+
+	// File is the name of the combined protocol definitions file, relative to
+	// the combined protocol definitions directory.
+	File string `json:"file,omitempty"`
+
+	// SHA256 is the hex encoded SHA-256 of the file's contents.
+	SHA256 string `json:"sha256,omitempty"`
+
+	// Error is the error retrieving the version, if any. Failed versions are
+	// retried by the next history fetch.
+	Error string `json:"error,omitempty"`
+}
+
+// readHistory reads the history manifest in dir, returning an empty manifest
+// when it does not exist.
+func readHistory(dir string) (*History, error) {
+	name := filepath.Join(dir, historyFile)
+	buf, err := ioutil.ReadFile(name)
+	switch {
+	case os.IsNotExist(err):
+		return new(History), nil
+	case err != nil:
+		return nil, err
+	}
+	var h History
+	if err = json.Unmarshal(buf, &h); err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	return &h, nil
+}
+
+// write writes the history manifest to dir.
+func (h *History) write(dir string) error {
+	sort.Slice(h.Versions, func(i, j int) bool {
+		return util.CompareVersions(h.Versions[i].Chromium, h.Versions[j].Chromium)
+	})
+	buf, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return err
+	}
+	return util.WriteFile(filepath.Join(dir, historyFile), append(buf, '\n'))
+}
+
+// set adds or replaces the chromium version in the manifest.
+func (h *History) set(v HistoryVersion) {
+	for i := range h.Versions {
+		if h.Versions[i].Chromium == v.Chromium {
+			h.Versions[i] = v
+			return
+		}
+	}
+	h.Versions = append(h.Versions, v)
+}
+
+// fetched returns true when the chromium version was previously fetched, and
+// its combined protocol definitions file is intact.
+func (h *History) fetched(dir, ver string) bool {
+	for _, v := range h.Versions {
+		if v.Chromium != ver || v.Error != "" || v.File == "" {
+			continue
+		}
+		buf, _, err := util.ReadEntry(filepath.Join(dir, v.File))
+		if err != nil {
+			return false
+		}
+		sum := sha256.Sum256(buf)
+		return hex.EncodeToString(sum[:]) == v.SHA256
+	}
+	return false
+}
+
+// historyFetch retrieves and combines the protocol definitions of the
+// selected chromium versions, using a pool of workers. Versions already in
+// the manifest are skipped, allowing an interrupted or failed fetch to be
+// resumed.
+func historyFetch(args []string) error {
+	fs := flag.NewFlagSet("history fetch", flag.ExitOnError)
+	versions := fs.String("versions", ">=67", "chromium version specifier of the versions to fetch (ie, '>=100 <120', or latest-patch-of:118)")
+	channel := fs.String("channel", "", "only fetch versions released on the release channel (stable, beta, dev, canary, or extended)")
+	majors := fs.Int("majors", 10, "number of most recent major versions to fetch (0 for all)")
+	perMajor := fs.Int("per-major", 10, "number of most recent versions of each major version to fetch (0 for all)")
+	workers := fs.Int("workers", 4, "number of concurrent workers")
+	inheritFlags(fs, "ttl", "timeout", "retries", "releases", "source", "chromium-src", "v8-src")
+	_ = fs.Parse(args)
+	switch {
+	case fs.NArg() != 0:
+		return errors.New("fetch takes no arguments")
+	case *workers < 1:
+		return errors.New("-workers must be at least 1")
+	}
+
+	// cancel on interrupt, keeping the manifest of completed versions
+	ctx, cancel := interruptContext()
+	defer cancel()
+
+	cl := &http.Client{Timeout: *flagTimeout}
+	util.Retries = *flagRetries
+	chromiumSrc, v8Src, err := sources(cl, nil)
+	if err != nil {
+		return err
+	}
+
+	// select versions
+	vers, err := historyVersions(ctx, chromiumSrc, releaseInfo(cl), *versions, *channel, *majors, *perMajor)
+	if err != nil {
+		return err
+	}
+	if len(vers) == 0 {
+		return fmt.Errorf("no chromium versions matching %q", *versions)
+	}
+
+	// skip previously fetched versions
+	dir := filepath.Join(*flagCache, "pdl", "combined")
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	h, err := readHistory(dir)
+	if err != nil {
+		return err
+	}
+	var todo []string
+	for _, ver := range vers {
+		if !h.fetched(dir, ver) {
+			todo = append(todo, ver)
+		}
+	}
+	util.Logf("HISTORY: %d versions (%d previously fetched), %d workers", len(vers), len(vers)-len(todo), *workers)
+
+	// fetch
+	jobs, results := make(chan string), make(chan HistoryVersion)
+	go func() {
+		defer close(jobs)
+		for _, ver := range todo {
+			select {
+			case jobs <- ver:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	var wg sync.WaitGroup
+	for i := 0; i < *workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ver := range jobs {
+				results <- historyFetchVersion(ctx, chromiumSrc, v8Src, dir, ver)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// record results as they complete
+	var n, failed int
+	for v := range results {
+		n++
+		if v.Error != "" {
+			failed++
+			util.Logf("[%d/%d] FAILED: %s: %s", n, len(todo), v.Chromium, v.Error)
+		} else {
+			util.Logf("[%d/%d] FETCHED: %s (v8 %s)", n, len(todo), v.Chromium, v.V8)
+		}
+		h.set(v)
+		if err == nil {
+			err = h.write(dir)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	util.Logf("WROTE: %s", filepath.Join(dir, historyFile))
+
+	switch {
+	case ctx.Err() != nil:
+		return fmt.Errorf("interrupted after %d of %d versions; run again to resume", n, len(todo))
+	case failed != 0:
+		return fmt.Errorf("%d of %d versions failed; run again to retry", failed, len(todo))
+	}
+	return nil
+}
+
+// historyVersions returns the chromium versions of the source matching the
+// version specifier, most recent first, limited to the versions released on
+// the release channel (when not empty), the most recent majors major
+// versions, and the most recent perMajor versions of each major version.
+func historyVersions(ctx context.Context, src util.Source, info util.ReleaseInfo, spec, channel string, majors, perMajor int) ([]string, error) {
+	vs, err := util.ParseVersionSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	if vs.Channel != "" {
+		return nil, fmt.Errorf("release channel %q is not a valid -versions specifier (use -channel)", vs.Channel)
+	}
+	tags, err := src.Versions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// filter to channel releases
+	if channel != "" {
+		released, err := info.ChannelVersions(ctx, channel)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[string]bool)
+		for _, ver := range released {
+			m[ver] = true
+		}
+		var v []string
+		for _, tag := range tags {
+			if m[tag] {
+				v = append(v, tag)
+			}
+		}
+		tags = v
+	}
+
+	v := util.SortVersions(tags)
+	var versions []string
+	var major, majorCount, count int
+	for i := len(v) - 1; i >= 0; i-- {
+		if !vs.Match(v[i]) {
+			continue
+		}
+		if v[i].Major != major {
+			if majors != 0 && majorCount == majors {
+				break
+			}
+			major, majorCount, count = v[i].Major, majorCount+1, 0
+		}
+		if perMajor != 0 && count == perMajor {
+			continue
+		}
+		versions = append(versions, v[i].String())
+		count++
+	}
+	return versions, nil
+}
+
+// historyFetchVersion retrieves the chromium and v8 protocol definitions of
+// the chromium version, and writes the combined protocol definitions to dir.
+func historyFetchVersion(ctx context.Context, chromiumSrc, v8Src util.Source, dir, ver string) HistoryVersion {
+	v := HistoryVersion{Chromium: ver}
+	err := func() error {
+		chromiumDef, err := loadSource(ctx, chromiumSrc, util.ChromiumPath, ver)
+		if err != nil {
+			return err
+		}
+		if v.V8, err = util.DepVersion(ctx, chromiumSrc, v8Src, "v8", ver); err != nil {
+			return err
+		}
+		v8Def, err := loadSource(ctx, v8Src, util.V8Path, v.V8)
+		if err != nil {
+			return err
+		}
+		har, err := pdl.Parse([]byte(pdl.HAR))
+		if err != nil {
+			return err
+		}
+		combined, _, err := pdl.Combine(pdl.MergeError, chromiumDef, v8Def, har)
+		if err != nil {
+			return err
+		}
+		buf := combined.CanonicalBytes()
+		v.File = fmt.Sprintf("%s_%s.pdl", ver, v.V8)
+		if err = util.WriteEntry(filepath.Join(dir, v.File), buf, util.Meta{}); err != nil {
+			return err
+		}
+		sum := sha256.Sum256(buf)
+		v.SHA256 = hex.EncodeToString(sum[:])
+		return nil
+	}()
+	if err != nil {
+		return HistoryVersion{Chromium: ver, V8: v.V8, Error: err.Error()}
+	}
+	return v
+}
+
+// historyIndex indexes the versions in the history manifest.
+func historyIndex(args []string) error {
+	if len(args) != 0 {
+		return errors.New("index takes no arguments")
+	}
+	_, err := loadIndex(filepath.Join(*flagCache, "pdl", "combined"))
+	return err
+}
+
+// loadIndex loads the history index in dir, (re)building it from the
+// versions in the history manifest when it does not exist or is out of date.
+func loadIndex(dir string) (*pdl.Index, error) {
+	h, err := readHistory(dir)
+	if err != nil {
+		return nil, err
+	}
+	var versions []HistoryVersion
+	for _, v := range h.Versions {
+		if v.Error == "" && v.File != "" {
+			versions = append(versions, v)
+		}
+	}
+	if len(versions) == 0 {
+		return nil, errors.New("no versions in history; run history fetch first")
+	}
+
+	// use existing index when up to date (ie, built from the same files)
+	name := filepath.Join(dir, indexFile)
+	if buf, err := ioutil.ReadFile(name); err == nil {
+		var f indexFileData
+		if err = json.Unmarshal(buf, &f); err == nil && f.Index != nil && f.upToDate(versions) {
+			return f.Index, nil
+		}
+	}
+
+	// build
+	f := indexFileData{Index: pdl.NewIndex()}
+	for i, v := range versions {
+		util.Logf("[%d/%d] INDEXING: %s", i+1, len(versions), v.File)
+		buf, _, err := util.ReadEntry(filepath.Join(dir, v.File))
+		if err != nil {
+			return nil, err
+		}
+		p, err := pdl.Parse(buf, pdl.WithFilename(v.File))
+		if err != nil {
+			return nil, err
+		}
+		f.Index.Add(v.Chromium, p)
+		f.SHA256 = append(f.SHA256, v.SHA256)
+	}
+	buf, err := json.Marshal(f)
+	if err != nil {
+		return nil, err
+	}
+	util.Logf("WRITING: %s", name)
+	if err = util.WriteFile(name, append(buf, '\n')); err != nil {
+		return nil, err
+	}
+	return f.Index, nil
+}
+
+// indexFileData is the contents of the history index file: the index, and the
+// SHA-256 of the combined protocol definitions file of each indexed version.
+type indexFileData struct {
+	*pdl.Index
+	SHA256 []string `json:"sha256"`
+}
+
+// upToDate returns true when the index was built from the combined protocol
+// definitions files of the versions.
+func (f indexFileData) upToDate(versions []HistoryVersion) bool {
+	if len(f.Versions) != len(versions) || len(f.SHA256) != len(versions) {
+		return false
+	}
+	for i, v := range versions {
+		if f.Versions[i] != v.Chromium || f.SHA256[i] != v.SHA256 {
+			return false
+		}
+	}
+	return true
+}
+
+// historyQuery displays the index entries of an element and the elements it
+// contains as json, or with -bisect, finds the version in which the element
+// last changed.
+func historyQuery(args []string) error {
+	fs := flag.NewFlagSet("history query", flag.ExitOnError)
+	bisect := fs.Bool("bisect", false, "toggle finding the version in which the element last changed by bisecting the chromium versions, retrieving only the versions needed")
+	versions := fs.String("versions", ">=67", "chromium version specifier of the versions to bisect")
+	channel := fs.String("channel", "", "only bisect versions released on the release channel (stable, beta, dev, canary, or extended)")
+	inheritFlags(fs, "ttl", "timeout", "retries", "releases", "source", "chromium-src", "v8-src")
+	_ = fs.Parse(args)
+	if fs.NArg() != 1 {
+		return errors.New("query requires an element path (ie, Network.getCookies)")
+	}
+	path := fs.Arg(0)
+	dir := filepath.Join(*flagCache, "pdl", "combined")
+
+	var res interface{}
+	if *bisect {
+		var err error
+		if res, err = historyBisect(dir, path, *versions, *channel); err != nil {
+			return err
+		}
+	} else {
+		idx, err := loadIndex(dir)
+		if err != nil {
+			return err
+		}
+		items := idx.Query(path)
+		if len(items) == 0 {
+			return fmt.Errorf("%s does not appear in the %d indexed versions", path, len(idx.Versions))
+		}
+		res = historyQueryResult{
+			Path:  path,
+			First: idx.Versions[0],
+			Last:  idx.Versions[len(idx.Versions)-1],
+			Items: items,
+		}
+	}
+
+	buf, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(append(buf, '\n'))
+	return err
+}
+
+// historyQueryResult is the result of a history query.
+type historyQueryResult struct {
+	// Path is the queried element path.
+	Path string `json:"path"`
+
+	// First and Last are the first and last indexed versions.
+	First string `json:"first"`
+	Last  string `json:"last"`
+
+	// Items are the index entries of the element and the elements it
+	// contains.
+	Items []*pdl.IndexItem `json:"items"`
+}
+
+// historyBisectResult is the result of a history bisect.
+type historyBisectResult struct {
+	// Path is the bisected element path.
+	Path string `json:"path"`
+
+	// Before is the last version before the change, and After is the first
+	// version after it. Before is empty when the element did not change in
+	// the bisected versions.
+	Before string `json:"before,omitempty"`
+	After  string `json:"after"`
+
+	// From and To are the element's states (ie, absent, or a list of the
+	// present elements and their flags) in Before and After.
+	From string `json:"from,omitempty"`
+	To   string `json:"to"`
+
+	// Fetched is the number of versions retrieved or read from the cache.
+	Fetched int `json:"fetched"`
+}
+
+// historyBisect finds the version in which the element last changed (ie, was
+// added, removed, or became or stopped being experimental or deprecated),
+// retrieving only the versions needed by bisecting the selected chromium
+// versions. Retrieved versions are added to the history manifest.
+func historyBisect(dir, path, spec, channel string) (*historyBisectResult, error) {
+	ctx, cancel := interruptContext()
+	defer cancel()
+
+	cl := &http.Client{Timeout: *flagTimeout}
+	util.Retries = *flagRetries
+	chromiumSrc, v8Src, err := sources(cl, nil)
+	if err != nil {
+		return nil, err
+	}
+	vers, err := historyVersions(ctx, chromiumSrc, releaseInfo(cl), spec, channel, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(vers) == 0 {
+		return nil, fmt.Errorf("no chromium versions matching %q", spec)
+	}
+	// oldest first
+	for i, j := 0, len(vers)-1; i < j; i, j = i+1, j-1 {
+		vers[i], vers[j] = vers[j], vers[i]
+	}
+
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	h, err := readHistory(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// state returns the state of the element in the version
+	res := &historyBisectResult{Path: path}
+	states := make(map[int]string)
+	state := func(i int) (string, error) {
+		if s, ok := states[i]; ok {
+			return s, nil
+		}
+		ver := vers[i]
+		if !h.fetched(dir, ver) {
+			v := historyFetchVersion(ctx, chromiumSrc, v8Src, dir, ver)
+			h.set(v)
+			if err := h.write(dir); err != nil {
+				return "", err
+			}
+			if v.Error != "" {
+				return "", fmt.Errorf("%s: %s", ver, v.Error)
+			}
+		}
+		var file string
+		for _, v := range h.Versions {
+			if v.Chromium == ver {
+				file = v.File
+			}
+		}
+		buf, _, err := util.ReadEntry(filepath.Join(dir, file))
+		if err != nil {
+			return "", err
+		}
+		p, err := pdl.Parse(buf, pdl.WithFilename(file))
+		if err != nil {
+			return "", err
+		}
+		idx := pdl.NewIndex()
+		idx.Add(ver, p)
+		states[i] = indexState(idx, path)
+		res.Fetched++
+		util.Logf("BISECT: %s: %s", ver, states[i])
+		return states[i], nil
+	}
+
+	// bisect, keeping the state of hi equal to the last version's state, and
+	// the state of lo different from it
+	lo, hi := 0, len(vers)-1
+	last, err := state(hi)
+	if err != nil {
+		return nil, err
+	}
+	first, err := state(lo)
+	if err != nil {
+		return nil, err
+	}
+	res.After, res.To = vers[hi], last
+	if first == last {
+		res.After = vers[lo]
+		return res, nil
+	}
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		s, err := state(mid)
+		if err != nil {
+			return nil, err
+		}
+		if s == last {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	res.Before, res.From, res.After = vers[lo], states[lo], vers[hi]
+	return res, nil
+}
+
+// indexState returns the state of the element with the path in the index of
+// a single version: absent, or the present elements and their flags.
+func indexState(idx *pdl.Index, path string) string {
+	var v []string
+	for _, item := range idx.Items {
+		if item.Path != path {
+			continue
+		}
+		s := item.Element
+		if item.Experimental {
+			s += " (experimental)"
+		}
+		if item.Deprecated {
+			s += " (deprecated)"
+		}
+		v = append(v, s)
+	}
+	if len(v) == 0 {
+		return "absent"
+	}
+	return strings.Join(v, ", ")
+}
+
+// interruptContext returns a context canceled on interrupt.
+func interruptContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt)
+	go func() {
+		select {
+		case <-sig:
+			util.Logf("INTERRUPTED: stopping")
+			cancel()
+		case <-ctx.Done():
+		}
+		signal.Stop(sig)
+	}()
+	return ctx, cancel
+}
+
+// inheritFlags adds the named command-line flags to fs, sharing their values.
+func inheritFlags(fs *flag.FlagSet, names ...string) {
+	for _, name := range names {
+		f := flag.Lookup(name)
+		fs.Var(f.Value, f.Name, f.Usage)
+	}
+}
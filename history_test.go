@@ -0,0 +1,320 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/chromedp/cdproto-gen/util"
+)
+
+func TestLoadIndex(t *testing.T) {
+	defer func(logf func(string, ...interface{})) { util.Logf = logf }(util.Logf)
+	util.Logf = t.Logf
+
+	dir, err := ioutil.TempDir("", "cdproto-gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// write writes the combined protocol definitions of the versions and the
+	// history manifest
+	write := func(versions map[string]string) {
+		h := new(History)
+		for ver, buf := range versions {
+			name := ver + "_12.0.267.8.pdl"
+			if err := util.WriteEntry(filepath.Join(dir, name), []byte(buf), util.Meta{}); err != nil {
+				t.Fatal(err)
+			}
+			sum := sha256.Sum256([]byte(buf))
+			h.set(HistoryVersion{Chromium: ver, V8: "12.0.267.8", File: name, SHA256: hex.EncodeToString(sum[:])})
+		}
+		if err := h.write(dir); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// since returns the first version of the command in the index
+	since := func(path string) string {
+		idx, err := loadIndex(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, item := range idx.Items {
+			if item.Element == "command" && item.Path == path {
+				return item.Since
+			}
+		}
+		return ""
+	}
+
+	write(map[string]string{
+		"119.0.6045.105": "domain A\n  command a\n",
+		"120.0.6099.109": "domain A\n  command a\n  command b\n",
+	})
+	if v := since("A.b"); v != "120.0.6099.109" {
+		t.Errorf("expected A.b since 120.0.6099.109, got: %q", v)
+	}
+
+	// cached index
+	if v := since("A.b"); v != "120.0.6099.109" {
+		t.Errorf("expected A.b since 120.0.6099.109, got: %q", v)
+	}
+
+	// same versions, different contents (ie, refetched)
+	write(map[string]string{
+		"119.0.6045.105": "domain A\n  command a\n  command b\n",
+		"120.0.6099.109": "domain A\n  command a\n  command b\n",
+	})
+	if v := since("A.b"); v != "119.0.6045.105" {
+		t.Errorf("expected rebuilt index with A.b since 119.0.6045.105, got: %q", v)
+	}
+
+	// index without hashes
+	if err := ioutil.WriteFile(filepath.Join(dir, indexFile), []byte(`{"versions":["119.0.6045.105","120.0.6099.109"],"items":[]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if v := since("A.b"); v != "119.0.6045.105" {
+		t.Errorf("expected rebuilt index with A.b since 119.0.6045.105, got: %q", v)
+	}
+}
+
+// historyV8Rev and historyV8Untagged are the tagged and untagged v8
+// revisions used by the chromium versions of the history test sources.
+const (
+	historyV8Rev      = "1111111111111111111111111111111111111111"
+	historyV8Untagged = "2222222222222222222222222222222222222222"
+)
+
+// historyChromium are the chromium versions of the history test sources.
+var historyChromium = []string{
+	"118.0.5993.70",
+	"118.0.5993.117",
+	"119.0.6045.105",
+	"120.0.6099.5",
+	"120.0.6099.109",
+	"121.0.6167.16",
+}
+
+// newHistorySources creates chromium and v8 sources of pre-fetched files in
+// dir. The v8 revision of the most recent chromium version is untagged.
+func newHistorySources(t *testing.T, dir string) (string, string) {
+	t.Helper()
+	chromiumDir, v8Dir := filepath.Join(dir, "chromium"), filepath.Join(dir, "v8")
+	write := func(name, buf string) {
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(buf), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var tags []string
+	for i, ver := range historyChromium {
+		rev := historyV8Rev
+		if i == len(historyChromium)-1 {
+			rev = historyV8Untagged
+		}
+		tags = append(tags, fmt.Sprintf("%040d refs/tags/%s", i, ver))
+		write(filepath.Join(chromiumDir, ver, "DEPS"), "vars = {\n  'v8_revision': '"+rev+"',\n}\n")
+		write(filepath.Join(chromiumDir, ver, filepath.FromSlash(util.ChromiumPath)), "domain A\n  command a"+strings.Replace(ver, ".", "_", -1)+"\n")
+	}
+	write(filepath.Join(chromiumDir, "tags"), strings.Join(append(tags, strings.Repeat("f", 40)+" refs/tags/v1.0"), "\n"))
+	write(filepath.Join(v8Dir, "tags"), historyV8Rev+" refs/tags/12.0.267.8\n")
+	for _, ref := range []string{"12.0.267.8", historyV8Untagged} {
+		write(filepath.Join(v8Dir, ref, filepath.FromSlash(util.V8Path)), "domain B\n  command b\n")
+	}
+	return chromiumDir, v8Dir
+}
+
+func TestHistoryVersions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cdproto-gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	chromiumDir, _ := newHistorySources(t, dir)
+	releases := filepath.Join(dir, "releases.json")
+	if err := ioutil.WriteFile(releases, []byte(`[
+  {"channel": "Stable", "version": "120.0.6099.109"},
+  {"channel": "Stable", "version": "119.0.6045.105"},
+  {"channel": "Stable", "version": "118.0.5993.70"},
+  {"channel": "Stable", "version": "117.0.5938.149"},
+  {"channel": "Beta", "version": "121.0.6167.16"}
+]`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		spec, channel    string
+		majors, perMajor int
+		exp              string
+		err              string
+	}{
+		{">=67", "", 10, 10, "121.0.6167.16 120.0.6099.109 120.0.6099.5 119.0.6045.105 118.0.5993.117 118.0.5993.70", ""},
+		{">=67", "", 2, 10, "121.0.6167.16 120.0.6099.109 120.0.6099.5", ""},
+		{">=67", "", 0, 1, "121.0.6167.16 120.0.6099.109 119.0.6045.105 118.0.5993.117", ""},
+		{">=67", "", 3, 1, "121.0.6167.16 120.0.6099.109 119.0.6045.105", ""},
+		{">=119 <121", "", 0, 0, "120.0.6099.109 120.0.6099.5 119.0.6045.105", ""},
+		{"latest-patch-of:118", "", 10, 10, "118.0.5993.117 118.0.5993.70", ""},
+		{"", "", 1, 0, "121.0.6167.16", ""},
+		{">=122", "", 10, 10, "", ""},
+		{">=67", "stable", 0, 0, "120.0.6099.109 119.0.6045.105 118.0.5993.70", ""},
+		{">=67", "stable", 1, 0, "120.0.6099.109", ""},
+		{">=67", "Beta", 0, 0, "121.0.6167.16", ""},
+		{">=67", "canary", 0, 0, "", releases + ": no canary release version"},
+		{"stable", "", 10, 10, "", `release channel "stable" is not a valid -versions specifier (use -channel)`},
+		{"foo", "", 10, 10, "", `invalid version specifier "foo"`},
+	}
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			versions, err := historyVersions(context.Background(), util.Prefetched(chromiumDir), util.ReleaseFile(releases), test.spec, test.channel, test.majors, test.perMajor)
+			switch {
+			case test.err != "" && (err == nil || err.Error() != test.err):
+				t.Fatalf("expected error %q, got: %v", test.err, err)
+			case test.err == "" && err != nil:
+				t.Fatal(err)
+			}
+			if s := strings.Join(versions, " "); s != test.exp {
+				t.Errorf("expected %q, got: %q", test.exp, s)
+			}
+		})
+	}
+}
+
+func TestHistoryFetch(t *testing.T) {
+	defer func(logf func(string, ...interface{}), retries int) { util.Logf, util.Retries = logf, retries }(util.Logf, util.Retries)
+	defer func(cache, source, chromiumSrc, v8Src string, retries int) {
+		*flagCache, *flagSource, *flagChromiumSrc, *flagV8Src, *flagRetries = cache, source, chromiumSrc, v8Src, retries
+	}(*flagCache, *flagSource, *flagChromiumSrc, *flagV8Src, *flagRetries)
+
+	// capture logs, which are also written by the workers
+	var mu sync.Mutex
+	var logs []string
+	util.Logf = func(s string, v ...interface{}) {
+		mu.Lock()
+		defer mu.Unlock()
+		logs = append(logs, fmt.Sprintf(s, v...))
+		t.Logf(s, v...)
+	}
+
+	dir, err := ioutil.TempDir("", "cdproto-gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	chromiumDir, v8Dir := newHistorySources(t, dir)
+	*flagCache, *flagSource, *flagChromiumSrc, *flagV8Src = filepath.Join(dir, "cache"), "dir", chromiumDir, v8Dir
+	combinedDir := filepath.Join(*flagCache, "pdl", "combined")
+
+	fetchedRE := regexp.MustCompile(`\] FETCHED: (\S+) `)
+	// fetch runs history fetch, returning the summary log line and the
+	// fetched versions, sorted
+	fetch := func(expErr string) (string, string) {
+		t.Helper()
+		logs = nil
+		err := historyFetch([]string{"-versions", ">=119", "-majors", "0", "-per-major", "0", "-workers", "2", "-retries", "0"})
+		switch {
+		case expErr != "" && (err == nil || err.Error() != expErr):
+			t.Fatalf("expected error %q, got: %v", expErr, err)
+		case expErr == "" && err != nil:
+			t.Fatal(err)
+		}
+		var summary string
+		var fetched []string
+		for _, s := range logs {
+			if strings.HasPrefix(s, "HISTORY: ") {
+				summary = s
+			}
+			if m := fetchedRE.FindStringSubmatch(s); m != nil {
+				fetched = append(fetched, m[1])
+			}
+		}
+		sort.Strings(fetched)
+		return summary, strings.Join(fetched, " ")
+	}
+
+	// failed version
+	deps := filepath.Join(chromiumDir, "120.0.6099.109", "DEPS")
+	depsBuf, err := ioutil.ReadFile(deps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(deps); err != nil {
+		t.Fatal(err)
+	}
+	summary, fetched := fetch("1 of 4 versions failed; run again to retry")
+	if exp := "HISTORY: 4 versions (0 previously fetched), 2 workers"; summary != exp {
+		t.Errorf("expected %q, got: %q", exp, summary)
+	}
+	if exp := "119.0.6045.105 120.0.6099.5 121.0.6167.16"; fetched != exp {
+		t.Errorf("expected fetched %s, got: %s", exp, fetched)
+	}
+	h, err := readHistory(combinedDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var versions []string
+	for _, v := range h.Versions {
+		versions = append(versions, v.Chromium)
+		switch {
+		case v.Chromium == "120.0.6099.109":
+			if v.Error == "" || v.File != "" {
+				t.Errorf("expected %s to have failed, got: %#v", v.Chromium, v)
+			}
+		case v.Chromium == "121.0.6167.16":
+			if v.V8 != historyV8Untagged || v.File != v.Chromium+"_"+historyV8Untagged+".pdl" {
+				t.Errorf("expected %s to use the untagged v8 revision, got: %#v", v.Chromium, v)
+			}
+		case v.V8 != "12.0.267.8" || v.File != v.Chromium+"_12.0.267.8.pdl" || v.Error != "":
+			t.Errorf("expected %s to use v8 12.0.267.8, got: %#v", v.Chromium, v)
+		}
+		if v.File != "" && !h.fetched(combinedDir, v.Chromium) {
+			t.Errorf("expected %s to be fetched", v.Chromium)
+		}
+	}
+	if s, exp := strings.Join(versions, " "), "119.0.6045.105 120.0.6099.5 120.0.6099.109 121.0.6167.16"; s != exp {
+		t.Errorf("expected manifest versions %s, got: %s", exp, s)
+	}
+
+	// resume, retrying the failed version, and refetching a version whose
+	// file no longer matches its sha256
+	if err := ioutil.WriteFile(deps, depsBuf, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(combinedDir, "119.0.6045.105_12.0.267.8.pdl"), []byte("domain A\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	summary, fetched = fetch("")
+	if exp := "HISTORY: 4 versions (2 previously fetched), 2 workers"; summary != exp {
+		t.Errorf("expected %q, got: %q", exp, summary)
+	}
+	if exp := "119.0.6045.105 120.0.6099.109"; fetched != exp {
+		t.Errorf("expected fetched %s, got: %s", exp, fetched)
+	}
+
+	// up to date
+	summary, fetched = fetch("")
+	if exp := "HISTORY: 4 versions (4 previously fetched), 2 workers"; summary != exp {
+		t.Errorf("expected %q, got: %q", exp, summary)
+	}
+	if fetched != "" {
+		t.Errorf("expected nothing fetched, got: %s", fetched)
+	}
+	files, err := combinedFiles(combinedDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 4 {
+		t.Errorf("expected 4 combined files, got: %d", len(files))
+	}
+}
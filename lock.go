@@ -0,0 +1,360 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"strings"
+
+	"github.com/chromedp/cdproto-gen/pdl"
+	"github.com/chromedp/cdproto-gen/util"
+)
+
+// lockFile is the name of the lock file written to the out directory.
+const lockFile = "cdproto-gen.lock"
+
+// Lock records the inputs of a generator run, allowing the output to be
+// reproduced with -locked.
+type Lock struct {
+	// Generator is the generator's module path and version.
+	Generator string `json:"generator"`
+
+	// Chromium and V8 are the protocol versions.
+	Chromium string `json:"chromium"`
+	V8       string `json:"v8"`
+
+	// Source is the protocol source kind, and Sources the chromium and v8
+	// source locations (ie, the gitiles base urls).
+	Source  string            `json:"source,omitempty"`
+	Sources map[string]string `json:"sources,omitempty"`
+
+	// Flags are the command-line flags affecting the output.
+	Flags []LockFlag `json:"flags,omitempty"`
+
+	// Inputs are the input files.
+	Inputs []LockInput `json:"inputs"`
+}
+
+// LockFlag is a command-line flag recorded in a lock. Repeatable flags are
+// recorded once per value.
+type LockFlag struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// LockInput is an input file recorded in a lock.
+type LockInput struct {
+	// Source is the input's source (ie, chromium, v8, har, pdl, overlay, or
+	// endpoint).
+	Source string `json:"source"`
+
+	// Ref is the ref the input was read at, if any.
+	Ref string `json:"ref,omitempty"`
+
+	// Name is the input's name.
+	Name string `json:"name"`
+
+	// SHA256 is the hex encoded SHA-256 of the input's contents.
+	SHA256 string `json:"sha256"`
+}
+
+// String satisfies the fmt.Stringer interface.
+func (in LockInput) String() string {
+	s := in.Source + ":"
+	if in.Ref != "" {
+		s += in.Ref + ":"
+	}
+	return s + in.Name
+}
+
+// unlockedFlags are the flags not affecting the output, and not recorded in
+// locks. The chromium and v8 versions and sources are recorded separately.
+var unlockedFlags = map[string]bool{
+	"cache":        true,
+	"changelog":    true,
+	"chromium":     true,
+	"chromium-src": true,
+	"debug":        true,
+	"graph":        true,
+	"json":         true,
+	"latest":       true,
+	"locked":       true,
+	"no-clean":     true,
+	"offline":      true,
+	"out":          true,
+	"releases":     true,
+	"retries":      true,
+	"source":       true,
+	"timeout":      true,
+	"ttl":          true,
+	"v8":           true,
+	"v8-src":       true,
+}
+
+// pathFlags are the flags whose values are file paths, recorded in locks
+// relative to the out directory.
+var pathFlags = map[string]bool{
+	"overlay": true,
+	"pdl":     true,
+}
+
+// localSources are the source kinds whose locations are file paths, recorded
+// in locks relative to the out directory.
+var localSources = map[string]bool{
+	"checkout": true,
+	"dir":      true,
+	"git":      true,
+}
+
+// locker records the inputs of the generator for the lock, verifying them
+// against a previous lock when -locked.
+type locker struct {
+	lock   *Lock
+	locked map[string]string
+}
+
+// newLocker creates a locker. When -locked, reads the lock from the out
+// directory and applies its versions, sources, and flags.
+func newLocker() (*locker, error) {
+	l := &locker{
+		lock: &Lock{
+			Generator: generatorVersion(),
+		},
+	}
+	flag.Visit(func(f *flag.Flag) {
+		if unlockedFlags[f.Name] {
+			return
+		}
+		if v, ok := f.Value.(*stringSlice); ok {
+			for _, s := range *v {
+				l.lock.Flags = append(l.lock.Flags, lockFlag(f.Name, s))
+			}
+			return
+		}
+		l.lock.Flags = append(l.lock.Flags, lockFlag(f.Name, f.Value.String()))
+	})
+	if !*flagLocked {
+		return l, nil
+	}
+
+	// read lock
+	name := filepath.Join(*flagOut, lockFile)
+	buf, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	var lock Lock
+	if err = json.Unmarshal(buf, &lock); err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	util.Logf("LOCKED: %s", name)
+	if lock.Generator != l.lock.Generator {
+		util.Logf("WARNING: lock generated by %s, not %s", lock.Generator, l.lock.Generator)
+	}
+
+	// apply flags
+	set := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+	for _, name := range []string{"chromium", "v8", "latest", "source", "chromium-src", "v8-src"} {
+		if set[name] {
+			return nil, fmt.Errorf("-%s cannot be used with -locked", name)
+		}
+	}
+	if len(l.lock.Flags) != 0 {
+		return nil, fmt.Errorf("-%s cannot be used with -locked", l.lock.Flags[0].Name)
+	}
+	for _, f := range lock.Flags {
+		if unlockedFlags[f.Name] || flag.Lookup(f.Name) == nil {
+			return nil, fmt.Errorf("%s: invalid flag -%s", name, f.Name)
+		}
+		value := f.Value
+		if pathFlags[f.Name] {
+			value = unlockPath(value)
+		}
+		if err = flag.Set(f.Name, value); err != nil {
+			return nil, fmt.Errorf("%s: -%s: %v", name, f.Name, err)
+		}
+	}
+	l.lock.Flags = lock.Flags
+	*flagChromium, *flagV8 = lock.Chromium, lock.V8
+	if lock.Source != "" {
+		*flagSource = lock.Source
+		*flagChromiumSrc, *flagV8Src = lock.Sources["chromium"], lock.Sources["v8"]
+		if localSources[lock.Source] {
+			*flagChromiumSrc, *flagV8Src = unlockPath(*flagChromiumSrc), unlockPath(*flagV8Src)
+		}
+	}
+
+	// index inputs
+	l.locked = make(map[string]string)
+	for _, in := range lock.Inputs {
+		l.locked[in.String()] = in.SHA256
+	}
+	return l, nil
+}
+
+// input records the contents of an input, returning an error when -locked
+// and the contents do not match the lock.
+func (l *locker) input(source, ref, name string, buf []byte) error {
+	sum := sha256.Sum256(buf)
+	in := LockInput{
+		Source: source,
+		Ref:    ref,
+		Name:   name,
+		SHA256: hex.EncodeToString(sum[:]),
+	}
+	if l.locked != nil {
+		switch z, ok := l.locked[in.String()]; {
+		case !ok:
+			return fmt.Errorf("input %s is not in %s", in, lockFile)
+		case z != in.SHA256:
+			return fmt.Errorf("input %s sha256 %s does not match %s sha256 %s", in, in.SHA256, lockFile, z)
+		}
+	}
+	for _, z := range l.lock.Inputs {
+		if z == in {
+			return nil
+		}
+	}
+	l.lock.Inputs = append(l.lock.Inputs, in)
+	return nil
+}
+
+// sources records the protocol sources.
+func (l *locker) sources(kind, chromium, v8 string) {
+	if localSources[kind] {
+		chromium, v8 = lockPath(chromium), lockPath(v8)
+	}
+	l.lock.Source = kind
+	l.lock.Sources = map[string]string{
+		"chromium": chromium,
+		"v8":       v8,
+	}
+}
+
+// write writes the lock to the out directory.
+func (l *locker) write() error {
+	l.lock.Chromium, l.lock.V8 = *flagChromium, *flagV8
+	sort.Slice(l.lock.Inputs, func(i, j int) bool {
+		return l.lock.Inputs[i].String() < l.lock.Inputs[j].String()
+	})
+	buf, err := json.MarshalIndent(l.lock, "", "  ")
+	if err != nil {
+		return err
+	}
+	name := filepath.Join(*flagOut, lockFile)
+	util.Logf("WRITING: %s", name)
+	return util.WriteFile(name, append(buf, '\n'))
+}
+
+// loadFile loads the pdl or json protocol definitions file, recording it and
+// any included files as inputs from source.
+func (l *locker) loadFile(source, name string) (*pdl.PDL, error) {
+	buf, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	if err = l.input(source, "", lockPath(name), buf); err != nil {
+		return nil, err
+	}
+	if strings.HasSuffix(name, ".json") {
+		return pdl.ParseJSON(buf)
+	}
+	return pdl.Parse(buf, pdl.WithFileSystem(lockFS{
+		l:      l,
+		fs:     pdl.Dir(filepath.Dir(name)),
+		source: source,
+		dir:    path.Dir(lockPath(name)),
+	}), pdl.WithFilename(filepath.Base(name)), pdl.WithAllErrors())
+}
+
+// lockFlag returns the lock flag for the flag name and value, with file paths
+// made relative to the out directory.
+func lockFlag(name, value string) LockFlag {
+	if pathFlags[name] {
+		value = lockPath(value)
+	}
+	return LockFlag{name, value}
+}
+
+// lockPath returns the slash-separated path of the named file relative to the
+// out directory, or its absolute path when it cannot be made relative (ie, is
+// on a different volume), so that locks do not depend on the working
+// directory.
+func lockPath(name string) string {
+	abs, err := filepath.Abs(name)
+	if err != nil {
+		return filepath.ToSlash(name)
+	}
+	if rel, err := filepath.Rel(*flagOut, abs); err == nil {
+		return filepath.ToSlash(rel)
+	}
+	return filepath.ToSlash(abs)
+}
+
+// unlockPath returns the path of a file path recorded in a lock, resolving
+// relative paths against the out directory.
+func unlockPath(name string) string {
+	name = filepath.FromSlash(name)
+	if filepath.IsAbs(name) {
+		return name
+	}
+	return filepath.Join(*flagOut, name)
+}
+
+// lockFS is a pdl.FileSystem recording the files read as inputs.
+type lockFS struct {
+	l      *locker
+	fs     pdl.FileSystem
+	source string
+	dir    string
+}
+
+// ReadFile satisfies the pdl.FileSystem interface.
+func (fs lockFS) ReadFile(name string) ([]byte, error) {
+	buf, err := fs.fs.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	if err = fs.l.input(fs.source, "", path.Join(fs.dir, name), buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// lockSource is a util.Source recording the files read as inputs.
+type lockSource struct {
+	util.Source
+	l    *locker
+	name string
+}
+
+// ReadFile satisfies the util.Source interface.
+func (src lockSource) ReadFile(ctx context.Context, ref, name string) ([]byte, error) {
+	buf, err := src.Source.ReadFile(ctx, ref, name)
+	if err != nil {
+		return nil, err
+	}
+	if err = src.l.input(src.name, ref, name, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// generatorVersion returns the generator's module path and version.
+func generatorVersion() string {
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		return bi.Main.Path + "@" + bi.Main.Version
+	}
+	return "unknown"
+}
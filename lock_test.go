@@ -0,0 +1,96 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLockPath(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cdproto-gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(out string) { *flagOut = out }(*flagOut)
+	*flagOut = filepath.Join(dir, "out")
+
+	tests := []struct {
+		name string
+		exp  string
+	}{
+		{filepath.Join(dir, "out", "custom.pdl"), "custom.pdl"},
+		{filepath.Join(dir, "pdl", "browser_protocol.pdl"), "../pdl/browser_protocol.pdl"},
+		{filepath.Join(dir, "out", "..", "overlay.json"), "../overlay.json"},
+	}
+	for i, test := range tests {
+		s := lockPath(test.name)
+		if s != test.exp {
+			t.Errorf("test %d expected %q, got: %q", i, test.exp, s)
+		}
+		if z := unlockPath(s); z != filepath.Clean(test.name) {
+			t.Errorf("test %d expected %q to resolve to %q, got: %q", i, s, filepath.Clean(test.name), z)
+		}
+	}
+
+	// relative to the working directory
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := lockPath("x.pdl")
+	if z := unlockPath(s); z != filepath.Join(wd, "x.pdl") {
+		t.Errorf("expected %q to resolve to %q, got: %q", s, filepath.Join(wd, "x.pdl"), z)
+	}
+
+	// absolute paths are kept
+	if abs := filepath.Join(dir, "x.pdl"); unlockPath(filepath.ToSlash(abs)) != abs {
+		t.Errorf("expected %q to resolve to itself", abs)
+	}
+}
+
+func TestLoadFileLock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cdproto-gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(out string) { *flagOut = out }(*flagOut)
+	*flagOut = filepath.Join(dir, "out")
+
+	if err := os.MkdirAll(filepath.Join(dir, "pdl", "domains"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, buf := range map[string]string{
+		"main.pdl":      "include domains/A.pdl\n",
+		"domains/A.pdl": "domain A\n",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(dir, "pdl", filepath.FromSlash(name)), []byte(buf), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// record
+	lk := &locker{lock: new(Lock)}
+	if _, err := lk.loadFile("pdl", filepath.Join(dir, "pdl", "main.pdl")); err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, in := range lk.lock.Inputs {
+		names = append(names, in.String())
+	}
+	exp := []string{"pdl:../pdl/main.pdl", "pdl:../pdl/domains/A.pdl"}
+	if len(names) != len(exp) || names[0] != exp[0] || names[1] != exp[1] {
+		t.Fatalf("expected inputs %v, got: %v", exp, names)
+	}
+
+	// verify, with the path resolved from the lock
+	locked := &locker{lock: new(Lock), locked: make(map[string]string)}
+	for _, in := range lk.lock.Inputs {
+		locked.locked[in.String()] = in.SHA256
+	}
+	if _, err := locked.loadFile("pdl", unlockPath("../pdl/main.pdl")); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+}
@@ -16,9 +16,10 @@ import (
 	"fmt"
 	"go/format"
 	"io/ioutil"
+	"net/http"
 	"os"
+	"path"
 	"path/filepath"
-	"runtime"
 	"sort"
 	"strings"
 	"time"
@@ -39,18 +40,41 @@ import (
 
 const (
 	easyjsonGo = "easyjson.go"
+	versionGo  = "version.go"
 )
 
 var (
 	flagDebug = flag.Bool("debug", false, "toggle debug (writes generated files to disk without post-processing)")
 
-	flagTTL = flag.Duration("ttl", 24*time.Hour, "file retrieval caching ttl")
+	flagTTL     = flag.Duration("ttl", 24*time.Hour, "file retrieval caching ttl")
+	flagTimeout = flag.Duration("timeout", 2*time.Minute, "file retrieval timeout")
+	flagRetries = flag.Int("retries", 3, "number of times to retry file retrieval on transient failures")
 
-	flagChromium = flag.String("chromium", "", "chromium protocol version")
-	flagV8       = flag.String("v8", "", "v8 protocol version")
+	flagChromium = flag.String("chromium", "", "chromium protocol version or version specifier (ie, 120.0.6099.109, stable, '>=120 <121', or latest-patch-of:118)")
+	flagV8       = flag.String("v8", "", "v8 protocol version or version specifier (ie, 12.0.267.8, '>=12 <13', or latest-patch-of:12.0)")
 	flagLatest   = flag.Bool("latest", false, "use latest protocol")
+	flagReleases = flag.String("releases", "chromiumdash", "release info for chromium release channels (chromiumdash, or path to json file)")
+	flagOffline  = flag.Bool("offline", false, "toggle using only cached or embedded protocol definitions (no network access)")
+	flagCompat   = flag.String("compat", "", "version specifier of the history versions (see history fetch) to determine the chromium versions supporting each command and event from (ie, '>=95')")
+	flagVersions = flag.String("versions", "", "comma-separated chromium versions or version specifiers (ie, 118,120,stable) to generate into versioned packages (ie, <go-pkg>/v120)")
 
-	flagPdl = flag.String("pdl", "", "path to pdl file to use")
+	flagSource      = flag.String("source", "gitiles", "protocol source (gitiles, checkout, git, http, or dir)")
+	flagChromiumSrc = flag.String("chromium-src", "", "chromium source location (gitiles or http base url, or checkout, git repository, or pre-fetched directory path)")
+	flagV8Src       = flag.String("v8-src", "", "v8 source location (gitiles or http base url, or checkout, git repository, or pre-fetched directory path)")
+
+	flagLocked = flag.Bool("locked", false, "toggle regenerating from the "+lockFile+" file in the out directory, failing on any input mismatch")
+
+	flagPdl      = flag.String("pdl", "", "path to pdl or json protocol file to use")
+	flagEndpoint = flag.String("endpoint", "", "devtools endpoint url (ie, http://localhost:9222) to retrieve protocol from")
+	flagJSON     = flag.Bool("json", false, "toggle writing combined protocol definitions as json (with binary types mapped to string, as published by chromium)")
+
+	flagMerge   = flag.String("merge", "error", "strategy for combining protocol definitions defining the same domain (error, first-wins, last-wins, or deep-merge)")
+	flagOverlay = stringsFlag("overlay", "path to pdl or json overlay file to apply to protocol definitions (can be repeated)")
+
+	flagChangelog = flag.String("changelog", "text", "protocol changelog format (text, markdown, json, or none)")
+	flagGraph     = flag.String("graph", "", "path to write domain dependency graph to (dot or json)")
+
+	flagCircularDeps = flag.String("circular-deps", strings.Join(pdl.CircularDepOverrides, ","), "comma-separated list of types (ie, DOM.Node) always moved to the shared cdp package, in addition to the types determined from the domain import cycles (empty to use only the determined types)")
 
 	flagCache = flag.String("cache", "", "protocol cache directory")
 	flagOut   = flag.String("out", "", "package out directory")
@@ -73,6 +97,17 @@ func main() {
 		g = g
 	}
 
+	// run command
+	if len(os.Args) > 1 {
+		if f, ok := commands[os.Args[1]]; ok {
+			if err := f(os.Args[2:]); err != nil {
+				fmt.Fprintf(os.Stderr, "error: %v\n", err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
 	flag.Parse()
 
 	// run
@@ -82,11 +117,16 @@ func main() {
 	}
 }
 
-// run runs the generator.
-func run() error {
-	var err error
+// commands are the commands run instead of the generator when specified as
+// the first argument.
+var commands = map[string]func([]string) error{
+	"cache":   runCache,
+	"history": runHistory,
+	"query":   runQuery,
+}
 
-	// set cache path
+// setCache sets -cache to the default cache path when not specified.
+func setCache() error {
 	if *flagCache == "" {
 		cacheDir, err := os.UserCacheDir()
 		if err != nil {
@@ -94,51 +134,19 @@ func run() error {
 		}
 		*flagCache = filepath.Join(cacheDir, "cdproto-gen")
 	}
+	return nil
+}
 
-	// get latest versions
-	if *flagChromium == "" {
-		if *flagChromium, err = util.GetLatestVersion(util.Cache{
-			URL:  util.ChromiumBase,
-			Path: filepath.Join(*flagCache, "html", "chromium.html"),
-			TTL:  *flagTTL,
-		}); err != nil {
-			return err
-		}
-	}
-	if *flagV8 == "" {
-		if *flagLatest {
-			if *flagV8, err = util.GetLatestVersion(util.Cache{
-				URL:  util.V8Base,
-				Path: filepath.Join(*flagCache, "html", "v8.html"),
-				TTL:  *flagTTL,
-			}); err != nil {
-				return err
-			}
-		} else {
-			if *flagV8, err = util.GetDepVersion("v8", *flagChromium, util.Cache{
-				URL:    fmt.Sprintf(util.ChromiumDeps+"?format=TEXT", *flagChromium),
-				Path:   filepath.Join(*flagCache, "deps", "chromium", *flagChromium),
-				TTL:    *flagTTL,
-				Decode: true,
-			}, util.Cache{
-				URL:  util.V8Base + "/+refs?format=JSON",
-				Path: filepath.Join(*flagCache, "refs", "v8.json"),
-				TTL:  *flagTTL,
-			}); err != nil {
-				return err
-			}
-		}
-	}
+// run runs the generator.
+func run() error {
+	var err error
 
-	// load protocol definitions
-	protoDefs, err := loadProtoDefs()
-	if err != nil {
+	// set cache path
+	if err = setCache(); err != nil {
 		return err
 	}
-	sort.Slice(protoDefs.Domains, func(i, j int) bool {
-		return strings.Compare(protoDefs.Domains[i].Domain.String(), protoDefs.Domains[j].Domain.String()) <= 0
-	})
 
+	// set out path
 	if *flagOut == "" {
 		*flagOut = filepath.Join(os.Getenv("GOPATH"), "src", *flagGoPkg)
 	} else {
@@ -148,44 +156,153 @@ func run() error {
 		}
 	}
 
-	// create out directory
-	if err = os.MkdirAll(*flagOut, 0755); err != nil {
+	// read lock
+	lk, err := newLocker()
+	if err != nil {
 		return err
 	}
 
-	combinedDir := filepath.Join(*flagCache, "pdl", "combined")
-	if err = os.MkdirAll(combinedDir, 0755); err != nil {
+	// generate multiple versions
+	if *flagVersions != "" {
+		return generateVersions(lk)
+	}
+
+	// load protocol definitions
+	protoDefs, browserVer, err := loadProtocol(lk)
+	if err != nil {
+		return err
+	}
+
+	// validate protocol definitions
+	if err = validate(protoDefs); err != nil {
+		return err
+	}
+
+	// determine supported versions
+	var c *compat
+	if *flagCompat != "" {
+		if c, err = loadCompat(lk, protoDefs, browserVer); err != nil {
+			return err
+		}
+	}
+
+	// write domain dependency graph
+	if *flagGraph != "" {
+		if err = writeGraph(*flagGraph, protoDefs); err != nil {
+			return err
+		}
+	}
+
+	sortDomains(protoDefs)
+
+	// create out directory
+	if err = os.MkdirAll(*flagOut, 0755); err != nil {
 		return err
 	}
-	protoFile := filepath.Join(combinedDir, fmt.Sprintf("%s_%s.pdl", *flagChromium, *flagV8))
 
 	// write protocol definitions
-	if *flagPdl == "" {
-		util.Logf("WRITING: %s", protoFile)
-		if err = ioutil.WriteFile(protoFile, protoDefs.Bytes(), 0644); err != nil {
+	if *flagPdl == "" && *flagEndpoint == "" && len(*flagOverlay) == 0 {
+		protoFile, err := writeCombined(protoDefs)
+		if err != nil {
 			return err
 		}
 
-		// display differences between generated definitions and previous version on disk
-		if runtime.GOOS != "windows" {
-			diffBuf, err := diff.WalkAndCompare(combinedDir, `^([0-9_.]+)\.pdl$`, protoFile, func(a, b *diff.FileInfo) bool {
-				n := strings.Split(strings.TrimSuffix(filepath.Base(a.Name), ".pdl"), "_")
-				m := strings.Split(strings.TrimSuffix(filepath.Base(b.Name), ".pdl"), "_")
-				if n[0] == m[0] {
-					return util.CompareSemver(n[1], m[1])
-				}
-				return util.CompareSemver(n[0], m[0])
-			})
-			if err != nil {
-				return err
-			}
-			if diffBuf != nil {
-				os.Stdout.Write(diffBuf)
-			}
+		// display changes between generated definitions and previous version on disk
+		if err = changelog(filepath.Dir(protoFile), protoFile, protoDefs); err != nil {
+			return err
 		}
 	}
 
 	// determine what to process
+	processed, pkgs, err := processDomains(protoDefs)
+	if err != nil {
+		return err
+	}
+
+	if c != nil {
+		c.apply(processed)
+	}
+
+	// get generator
+	generator := gen.Generators()["go"]
+	if generator == nil {
+		return errors.New("no generator")
+	}
+
+	// emit
+	emitter, err := generator(processed, *flagGoPkg)
+	if err != nil {
+		return err
+	}
+	files := emitter.Emit()
+
+	// add compatibility table
+	if c != nil {
+		files[compatGo] = c.file(path.Base(*flagGoPkg), processed)
+	}
+
+	// record endpoint browser versions
+	if browserVer != nil {
+		files[versionGo] = versionFile(path.Base(*flagGoPkg), browserVer)
+	}
+
+	// write files
+	if err = output(files, pkgs); err != nil {
+		return err
+	}
+
+	// write lock
+	if err = lk.write(); err != nil {
+		return err
+	}
+
+	util.Logf("done.")
+	return nil
+}
+
+// loadProtocol loads the protocol definitions specified by the command-line
+// flags, applying any overlays. Returns the browser version when retrieved
+// from a devtools endpoint.
+func loadProtocol(lk *locker) (*pdl.PDL, *util.BrowserVersion, error) {
+	// disable network access
+	util.Offline = *flagOffline
+
+	// http client
+	ctx := context.Background()
+	cl := &http.Client{Timeout: *flagTimeout}
+	util.Retries = *flagRetries
+
+	// load protocol definitions
+	var protoDefs *pdl.PDL
+	var browserVer *util.BrowserVersion
+	var err error
+	switch {
+	case *flagEndpoint != "" && *flagOffline:
+		return nil, nil, errors.New("-endpoint cannot be used with -offline")
+	case *flagEndpoint != "":
+		protoDefs, browserVer, err = loadEndpoint(ctx, cl, lk, *flagEndpoint)
+	case *flagOffline && *flagPdl == "" && !*flagLocked:
+		protoDefs, err = loadOffline(ctx, cl, lk)
+	default:
+		protoDefs, err = loadProtoDefs(ctx, cl, lk)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// apply overlays
+	if err = applyOverlays(lk, protoDefs); err != nil {
+		return nil, nil, err
+	}
+	return protoDefs, browserVer, nil
+}
+
+// processDomains prepares the protocol definitions for generation, returning
+// the domains to generate and their packages. Deprecated domains, types,
+// commands, events, and members, as well as redirects, are skipped, types
+// causing circular dependencies are marked for the shared cdp package, and the
+// fixups are applied.
+func processDomains(protoDefs *pdl.PDL) ([]*pdl.Domain, []string, error) {
 	pkgs := []string{"", "cdp"}
 	var processed []*pdl.Domain
 	for _, d := range protoDefs.Domains {
@@ -220,126 +337,489 @@ func run() error {
 		d.Commands = cleanupTypes("command", d.Domain.String(), d.Commands)
 	}
 
+	// determine types to move to the shared cdp package (before the fixups,
+	// which do not rename shared types)
+	deps, err := pdl.ResolveCircularDeps(processed, circularDepOverrides())
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, dep := range deps {
+		util.Logf("SHARED: %v", dep)
+	}
+
 	// fixup
 	fixup.FixDomains(processed)
 
-	// get generator
-	generator := gen.Generators()["go"]
-	if generator == nil {
-		return errors.New("no generator")
+	return processed, pkgs, nil
+}
+
+// circularDepOverrides returns the types always moved to the shared cdp
+// package, as specified by -circular-deps.
+func circularDepOverrides() []string {
+	var v []string
+	for _, z := range strings.Split(*flagCircularDeps, ",") {
+		if z = strings.TrimSpace(z); z != "" {
+			v = append(v, z)
+		}
 	}
+	return v
+}
 
-	// emit
-	emitter, err := generator(processed, *flagGoPkg)
+// validate validates the protocol definitions, logging any warnings.
+func validate(protoDefs *pdl.PDL) error {
+	var problems []string
+	for _, p := range pdl.Validate(protoDefs) {
+		if p.Warning {
+			util.Logf("WARNING: %v", p)
+			continue
+		}
+		problems = append(problems, p.Error())
+	}
+	if len(problems) != 0 {
+		return fmt.Errorf("invalid protocol definitions:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// sortDomains sorts the domains of the protocol definitions by name.
+func sortDomains(protoDefs *pdl.PDL) {
+	sort.Slice(protoDefs.Domains, func(i, j int) bool {
+		return strings.Compare(protoDefs.Domains[i].Domain.String(), protoDefs.Domains[j].Domain.String()) <= 0
+	})
+}
+
+// writeCombined writes the combined protocol definitions for the -chromium
+// and -v8 versions to the cache (and as json, when -json), returning the path
+// of the written file.
+func writeCombined(protoDefs *pdl.PDL) (string, error) {
+	combinedDir := filepath.Join(*flagCache, "pdl", "combined")
+	if err := os.MkdirAll(combinedDir, 0755); err != nil {
+		return "", err
+	}
+	protoFile := filepath.Join(combinedDir, fmt.Sprintf("%s_%s.pdl", *flagChromium, *flagV8))
+	util.Logf("WRITING: %s", protoFile)
+	if err := util.WriteEntry(protoFile, protoDefs.CanonicalBytes(), util.Meta{}); err != nil {
+		return "", err
+	}
+	if *flagJSON {
+		jsonFile := strings.TrimSuffix(protoFile, ".pdl") + ".json"
+		util.Logf("WRITING: %s", jsonFile)
+		if err := util.WriteEntry(jsonFile, protoDefs.JSONMapBinary(), util.Meta{}); err != nil {
+			return "", err
+		}
+	}
+	return protoFile, nil
+}
+
+// sources creates the chromium and v8 protocol sources specified by -source,
+// -chromium-src, and -v8-src, using the client to retrieve remote files, and
+// recording the files read as inputs in the lock (when not nil).
+func sources(cl *http.Client, lk *locker) (util.Source, util.Source, error) {
+	chromiumLoc, v8Loc := *flagChromiumSrc, *flagV8Src
+	if *flagSource == "gitiles" {
+		if chromiumLoc == "" {
+			chromiumLoc = util.ChromiumBase
+		}
+		if v8Loc == "" {
+			v8Loc = util.V8Base
+		}
+	}
+	if chromiumLoc == "" || v8Loc == "" {
+		return nil, nil, fmt.Errorf("-chromium-src and -v8-src must be specified for source %q", *flagSource)
+	}
+	chromiumSrc, err := util.NewSource(*flagSource, "chromium", chromiumLoc, *flagCache, *flagTTL, cl)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-	files := emitter.Emit()
+	v8Src, err := util.NewSource(*flagSource, "v8", v8Loc, *flagCache, *flagTTL, cl)
+	if err != nil {
+		return nil, nil, err
+	}
+	if lk == nil {
+		return chromiumSrc, v8Src, nil
+	}
+	lk.sources(*flagSource, chromiumLoc, v8Loc)
+	return lockSource{chromiumSrc, lk, "chromium"}, lockSource{v8Src, lk, "v8"}, nil
+}
 
-	// clean up files
-	if !*flagNoClean {
-		util.Logf("CLEANING: %s", *flagOut)
-		outpath := *flagOut + string(filepath.Separator)
-		err = filepath.Walk(outpath, func(n string, fi os.FileInfo, err error) error {
-			switch {
-			case os.IsNotExist(err) || n == outpath:
-				return nil
-			case err != nil:
-				return err
-			}
+// loadProtoDefs loads the protocol definitions either from the path specified
+// in -pdl or by retrieving the versions specified in the -chromium and -v8
+// flags from the chromium and v8 sources, determining the versions when not
+// specified.
+func loadProtoDefs(ctx context.Context, cl *http.Client, lk *locker) (*pdl.PDL, error) {
+	if *flagPdl != "" {
+		util.Logf("PROTOCOL: %s", *flagPdl)
+		return lk.loadFile("pdl", *flagPdl)
+	}
 
-			// skip if file or path starts with ., is whitelisted, or is one of
-			// the files whose output will be overwritten
-			pn, fn := n[len(outpath):], fi.Name()
-			if pn == "" || strings.HasPrefix(pn, ".") || strings.HasPrefix(fn, ".") || whitelisted(fn) || contains(files, pn) {
-				return nil
-			}
+	// create protocol sources
+	chromiumSrc, v8Src, err := sources(cl, lk)
+	if err != nil {
+		return nil, err
+	}
 
-			util.Logf("REMOVING: %s", n)
-			return os.RemoveAll(n)
-		})
-		if err != nil {
-			return err
+	// resolve versions
+	if *flagChromium, err = resolveVersion(ctx, "chromium", chromiumSrc, releaseInfo(cl), *flagChromium); err != nil {
+		return nil, err
+	}
+	if *flagV8 != "" || *flagLatest {
+		if *flagV8, err = resolveVersion(ctx, "v8", v8Src, nil, *flagV8); err != nil {
+			return nil, err
+		}
+	} else {
+		if *flagV8, err = util.DepVersion(ctx, chromiumSrc, v8Src, "v8", *flagChromium); err != nil {
+			return nil, err
 		}
 	}
 
-	util.Logf("WRITING: %d files", len(files))
+	// grab browser + js definition
+	chromiumDef, err := loadSource(ctx, chromiumSrc, util.ChromiumPath, *flagChromium)
+	if err != nil {
+		return nil, err
+	}
+	v8Def, err := loadSource(ctx, v8Src, util.V8Path, *flagV8)
+	if err != nil {
+		return nil, err
+	}
 
-	// dump files and exit
-	if *flagDebug {
-		return write(files)
+	return combine(lk, chromiumDef, v8Def)
+}
+
+// loadSource loads the named protocol definitions file from the source at the
+// ref, retrieving any included files relative to the file.
+func loadSource(ctx context.Context, src util.Source, name, ref string) (*pdl.PDL, error) {
+	buf, err := src.ReadFile(ctx, ref, name)
+	if err != nil {
+		return nil, err
 	}
+	return pdl.Parse(buf, pdl.WithFileSystem(util.SourceDir{
+		Source:  src,
+		Ref:     ref,
+		Dir:     path.Dir(name),
+		Context: ctx,
+	}), pdl.WithFilename(path.Base(name)))
+}
 
-	// goimports (also writes to disk)
-	if err = goimports(files); err != nil {
-		return err
+// combine combines the protocol definitions with the har definition, using
+// the strategy specified by -merge.
+func combine(lk *locker, protoDefs ...*pdl.PDL) (*pdl.PDL, error) {
+	// grab har definition
+	if err := lk.input("har", "", "har.pdl", []byte(pdl.HAR)); err != nil {
+		return nil, err
+	}
+	har, err := pdl.Parse([]byte(pdl.HAR))
+	if err != nil {
+		return nil, err
 	}
 
-	// easyjson
-	if err = easyjson(pkgs); err != nil {
-		return err
+	// combine
+	strategy, err := pdl.ParseMergeStrategy(*flagMerge)
+	if err != nil {
+		return nil, err
+	}
+	combined, merges, err := pdl.Combine(strategy, append(protoDefs, har)...)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range merges {
+		util.Logf("MERGE: %v", m)
 	}
+	return combined, nil
+}
 
-	// gofmt
-	if err = gofmt(fmtFiles(files, pkgs)); err != nil {
-		return err
+// applyOverlays applies the overlay files specified by -overlay to the
+// protocol definitions, in order.
+func applyOverlays(lk *locker, protoDefs *pdl.PDL) error {
+	var overlays []*pdl.PDL
+	for _, name := range *flagOverlay {
+		util.Logf("OVERLAY: %s", name)
+		overlay, err := lk.loadFile("overlay", name)
+		if err != nil {
+			return err
+		}
+		overlays = append(overlays, overlay)
 	}
 
-	util.Logf("done.")
+	var problems []string
+	for _, p := range pdl.Overlay(protoDefs, overlays...) {
+		problems = append(problems, p.Error())
+	}
+	if len(problems) != 0 {
+		return fmt.Errorf("overlay conflicts:\n  %s", strings.Join(problems, "\n  "))
+	}
 	return nil
 }
 
-// loadProtoDefs loads the protocol definitions either from the path specified
-// in -proto or by retrieving the versions specified in the -browser and -js
-// files.
-func loadProtoDefs() (*pdl.PDL, error) {
-	var err error
+// releaseInfo returns the release info provider specified by -releases.
+func releaseInfo(cl *http.Client) util.ReleaseInfo {
+	return util.NewReleaseInfo(*flagReleases, *flagCache, *flagTTL, cl)
+}
 
-	if *flagPdl != "" {
-		util.Logf("PROTOCOL: %s", *flagPdl)
-		buf, err := ioutil.ReadFile(*flagPdl)
-		if err != nil {
+// resolveVersion resolves the version specifier for the named source.
+func resolveVersion(ctx context.Context, name string, src util.Source, info util.ReleaseInfo, spec string) (string, error) {
+	ver, err := util.ResolveVersion(ctx, src, info, spec)
+	if err != nil {
+		return "", fmt.Errorf("%s: %v", name, err)
+	}
+	if spec != "" && spec != ver {
+		util.Logf("VERSION(%s): %s -> %s", name, spec, ver)
+	}
+	return ver, nil
+}
+
+// loadOffline loads the protocol definitions for the versions specified in
+// -chromium and -v8 (or the most recent versions, when not specified) from the
+// combined protocol definitions in the cache or the embedded snapshot, falling
+// back to the cached chromium and v8 protocol definition files.
+func loadOffline(ctx context.Context, cl *http.Client, lk *locker) (*pdl.PDL, error) {
+	// resolve release channel from cached or local release info
+	chromiumSpec, err := util.ParseVersionSpec(*flagChromium)
+	if err != nil {
+		return nil, fmt.Errorf("chromium: %v", err)
+	}
+	if chromiumSpec.Channel != "" {
+		if *flagChromium, err = resolveVersion(ctx, "chromium", nil, releaseInfo(cl), *flagChromium); err != nil {
 			return nil, err
 		}
-		return pdl.Parse(buf)
+		chromiumSpec, _ = util.ParseVersionSpec(*flagChromium)
+	}
+	v8Spec, err := util.ParseVersionSpec(*flagV8)
+	if err != nil {
+		return nil, fmt.Errorf("v8: %v", err)
+	}
+	match := func(chromium, v8 string) bool {
+		return matchCombined(chromiumSpec, chromium) && matchCombined(v8Spec, v8)
 	}
 
-	var protoDefs []*pdl.PDL
-	load := func(urlstr, typ, ver string) error {
-		buf, err := util.Get(util.Cache{
-			URL:    fmt.Sprintf(urlstr+"?format=TEXT", ver),
-			Path:   filepath.Join(*flagCache, "pdl", typ, ver+".pdl"),
-			TTL:    *flagTTL,
-			Decode: true,
-		})
+	// cached combined protocol definitions
+	files, err := combinedFiles(filepath.Join(*flagCache, "pdl", "combined"))
+	if err != nil {
+		return nil, err
+	}
+	for i := len(files) - 1; i >= 0; i-- {
+		if v := combinedVersions(files[i].Name); match(v[0], v[1]) {
+			*flagChromium, *flagV8 = v[0], v[1]
+			util.Logf("PROTOCOL: %s", files[i].Name)
+			buf, _, err := util.ReadEntry(files[i].Name)
+			if err != nil {
+				return nil, err
+			}
+			if err = lk.input("combined", "", filepath.Base(files[i].Name), buf); err != nil {
+				return nil, err
+			}
+			return pdl.Parse(buf, pdl.WithFilename(files[i].Name))
+		}
+	}
+
+	// embedded snapshot
+	if pdl.Snapshot != "" && match(pdl.SnapshotChromium, pdl.SnapshotV8) {
+		*flagChromium, *flagV8 = pdl.SnapshotChromium, pdl.SnapshotV8
+		util.Logf("PROTOCOL: embedded snapshot (chromium %s, v8 %s)", pdl.SnapshotChromium, pdl.SnapshotV8)
+		if err = lk.input("snapshot", "", "snapshot.pdl", []byte(pdl.Snapshot)); err != nil {
+			return nil, err
+		}
+		return pdl.Parse([]byte(pdl.Snapshot))
+	}
+
+	// cached chromium and v8 protocol definitions
+	protoDefs, err := loadProtoDefs(ctx, cl, lk)
+	if err != nil {
+		return nil, fmt.Errorf("no cached or embedded protocol definitions available: %v", err)
+	}
+	return protoDefs, nil
+}
+
+// matchCombined returns true when the version of a combined protocol
+// definitions file satisfies the specifier. An untagged revision satisfies
+// only the exact revision, or an unspecified (latest) version.
+func matchCombined(spec *util.VersionSpec, ver string) bool {
+	if s := strings.TrimSpace(spec.Spec); util.RevRE.MatchString(ver) && (s == "" || s == "latest") {
+		return true
+	}
+	return spec.MatchString(ver)
+}
+
+// loadEndpoint loads the protocol definitions from the running browser's
+// devtools endpoint, setting -chromium and -v8 to the browser's reported
+// versions.
+func loadEndpoint(ctx context.Context, cl *http.Client, lk *locker, endpoint string) (*pdl.PDL, *util.BrowserVersion, error) {
+	ver, err := util.GetEndpointVersion(ctx, cl, endpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	*flagChromium, *flagV8 = ver.ChromeVersion(), ver.V8Version
+	util.Logf("BROWSER: %s (v8 %s, protocol %s)", ver.Browser, ver.V8Version, ver.ProtocolVersion)
+
+	buf, err := util.GetEndpointProtocol(ctx, cl, endpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = lk.input("endpoint", "", "json/protocol", buf); err != nil {
+		return nil, nil, err
+	}
+	protoDef, err := pdl.ParseJSON(buf)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s/json/protocol: %v", strings.TrimSuffix(endpoint, "/"), err)
+	}
+
+	combined, err := combine(lk, protoDef)
+	if err != nil {
+		return nil, nil, err
+	}
+	return combined, ver, nil
+}
+
+// versionFile returns the go source for the root package recording the
+// browser versions reported by the devtools endpoint.
+func versionFile(pkgName string, ver *util.BrowserVersion) *bytes.Buffer {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "// Code generated by cdproto-gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "package %s\n\n", pkgName)
+	fmt.Fprintf(buf, "// Browser versions reported by the DevTools endpoint the protocol definitions\n")
+	fmt.Fprintf(buf, "// were retrieved from.\n")
+	fmt.Fprintf(buf, "const (\n")
+	for _, v := range []struct{ name, desc, value string }{
+		{"Browser", "is the browser name and version", ver.Browser},
+		{"BrowserVersion", "is the browser version", ver.ChromeVersion()},
+		{"V8Version", "is the V8 version", ver.V8Version},
+		{"ProtocolVersion", "is the DevTools protocol version", ver.ProtocolVersion},
+		{"UserAgent", "is the browser user agent", ver.UserAgent},
+	} {
+		fmt.Fprintf(buf, "\t// %s %s.\n\t%s = %q\n", v.name, v.desc, v.name, v.value)
+	}
+	fmt.Fprintf(buf, ")\n")
+	return buf
+}
+
+// combinedMask is the file mask of the combined protocol definitions files
+// (ie, <chromium>_<v8>.pdl), where either version can be an untagged git
+// revision.
+const combinedMask = `^([0-9.]+|[0-9a-f]{40})_([0-9.]+|[0-9a-f]{40})\.pdl$`
+
+// combinedFiles returns the combined protocol definitions files in dir (ie,
+// <chromium>_<v8>.pdl), sorted by version. Untagged revisions sort before the
+// release versions.
+func combinedFiles(dir string) ([]*diff.FileInfo, error) {
+	files, err := diff.FindFilesWithMask(dir, combinedMask)
+	if err != nil {
+		return nil, err
+	}
+
+	// sort most recent
+	sort.Slice(files, func(a, b int) bool {
+		n := combinedVersions(files[a].Name)
+		m := combinedVersions(files[b].Name)
+		if n[0] == m[0] {
+			return util.CompareVersions(n[1], m[1])
+		}
+		return util.CompareVersions(n[0], m[0])
+	})
+	return files, nil
+}
+
+// combinedVersions returns the chromium and v8 versions of a combined protocol
+// definitions file.
+func combinedVersions(name string) []string {
+	return strings.Split(strings.TrimSuffix(filepath.Base(name), ".pdl"), "_")
+}
+
+// changelog displays the changes between the protocol definitions and the
+// most recent previous version in dir having changes, in the format specified
+// by -changelog, along with any breaking changes to the generated Go API and
+// the suggested semantic version bump.
+func changelog(dir, protoFile string, protoDefs *pdl.PDL) error {
+	switch *flagChangelog {
+	case "none":
+		return nil
+	case "text", "markdown", "json":
+	default:
+		return fmt.Errorf("invalid changelog format %q", *flagChangelog)
+	}
+
+	files, err := combinedFiles(dir)
+	if err != nil {
+		return err
+	}
+
+	// find protoFile in files
+	var i int
+	for ; i < len(files); i++ {
+		if filepath.Base(files[i].Name) == filepath.Base(protoFile) {
+			break
+		}
+	}
+
+	// display first with changes
+	for i--; i >= 0; i-- {
+		prev, err := pdl.LoadFile(files[i].Name)
 		if err != nil {
 			return err
 		}
+		cs := pdl.Diff(prev, protoDefs)
+		if len(cs.Changes) == 0 {
+			continue
+		}
+
+		util.Logf("CHANGES: %s -> %s", files[i], filepath.Base(protoFile))
+		var buf []byte
+		switch *flagChangelog {
+		case "text":
+			buf = cs.Text()
+		case "markdown":
+			buf = cs.Markdown()
+		case "json":
+			if buf, err = cs.JSON(); err != nil {
+				return err
+			}
+		}
+		if _, err = os.Stdout.Write(buf); err != nil {
+			return err
+		}
 
-		// parse
-		protoDef, err := pdl.Parse(buf)
+		// classify changes to the generated go api, reloading the protocol
+		// definitions so that marking the types of both versions moved to
+		// the shared cdp package does not affect those being generated
+		cur, err := pdl.LoadFile(protoFile)
 		if err != nil {
 			return err
 		}
-		protoDefs = append(protoDefs, protoDef)
+		for _, p := range []*pdl.PDL{prev, cur} {
+			if _, err = pdl.ResolveCircularDeps(p.Domains, circularDepOverrides()); err != nil {
+				return err
+			}
+		}
+		apiChanges := gen.ClassifyChanges(pdl.Diff(prev, cur))
+		for _, c := range apiChanges {
+			if c.Breaking() {
+				util.Logf("BREAKING: %v", c)
+			}
+		}
+		util.Logf("SEMVER: %s version bump suggested for %s", gen.SuggestBump(apiChanges), *flagGoPkg)
 		return nil
 	}
 
-	// grab browser + js definition
-	if err = load(util.ChromiumURL, "chromium", *flagChromium); err != nil {
-		return nil, err
-	}
-	if err = load(util.V8URL, "v8", *flagV8); err != nil {
-		return nil, err
+	return nil
+}
+
+// writeGraph writes the domain dependency graph for the protocol definitions
+// to the file, as JSON when the file has a .json extension, and otherwise as
+// DOT. Logs any unused or undeclared domain dependencies.
+func writeGraph(filename string, protoDefs *pdl.PDL) error {
+	g := pdl.NewGraph(protoDefs)
+	for _, p := range g.Check() {
+		util.Logf("DEPENDENCY: %v", p)
 	}
 
-	// grab har definition
-	har, err := pdl.Parse([]byte(pdl.HAR))
-	if err != nil {
-		return nil, err
+	buf := g.DOT()
+	if strings.HasSuffix(filename, ".json") {
+		var err error
+		if buf, err = g.JSON(); err != nil {
+			return err
+		}
 	}
 
-	return pdl.Combine(append(protoDefs, har)...), nil
+	util.Logf("WRITING: %s", filename)
+	return ioutil.WriteFile(filename, buf, 0644)
 }
 
 // cleanupTypes removes deprecated and redirected types.
@@ -376,6 +856,61 @@ func cleanupTypes(n string, dtyp string, typs []*pdl.Type) []*pdl.Type {
 	return ret
 }
 
+// output cleans the out directory (unless -no-clean) and writes the files,
+// running goimports, easyjson, and gofmt on the files and packages (unless
+// -debug).
+func output(files map[string]*bytes.Buffer, pkgs []string) error {
+	// clean up files
+	if !*flagNoClean {
+		util.Logf("CLEANING: %s", *flagOut)
+		outpath := *flagOut + string(filepath.Separator)
+		err := filepath.Walk(outpath, func(n string, fi os.FileInfo, err error) error {
+			switch {
+			case os.IsNotExist(err) || n == outpath:
+				return nil
+			case err != nil:
+				return err
+			}
+
+			// skip if file or path starts with ., is whitelisted, or is one of
+			// the files whose output will be overwritten
+			pn, fn := n[len(outpath):], fi.Name()
+			if pn == "" || strings.HasPrefix(pn, ".") || strings.HasPrefix(fn, ".") || pn == lockFile || whitelisted(fn) || contains(files, pn) {
+				return nil
+			}
+
+			util.Logf("REMOVING: %s", n)
+			return os.RemoveAll(n)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	util.Logf("WRITING: %d files", len(files))
+
+	// dump files and exit
+	if *flagDebug {
+		return write(files)
+	}
+
+	// goimports
+	if err := goimports(files); err != nil {
+		return err
+	}
+	if err := write(files); err != nil {
+		return err
+	}
+
+	// easyjson
+	if err := easyjson(pkgs); err != nil {
+		return err
+	}
+
+	// gofmt
+	return gofmt(fmtFiles(files, pkgs))
+}
+
 // write writes all file buffer to disk.
 func write(fileBuffers map[string]*bytes.Buffer) error {
 	var keys []string
@@ -401,7 +936,7 @@ func write(fileBuffers map[string]*bytes.Buffer) error {
 	return nil
 }
 
-// goimports formats all the output file buffers on disk using goimports.
+// goimports formats all the output file buffers using goimports.
 func goimports(fileBuffers map[string]*bytes.Buffer) error {
 	util.Logf("RUNNING: goimports")
 
@@ -415,15 +950,14 @@ func goimports(fileBuffers map[string]*bytes.Buffer) error {
 	for _, k := range keys {
 		eg.Go(func(n string) func() error {
 			return func() error {
-				fn := filepath.Join(*flagOut, n)
-				buf, err := imports.Process(fn, fileBuffers[n].Bytes(), nil)
+				b := fileBuffers[n]
+				buf, err := imports.Process(filepath.Join(*flagOut, n), b.Bytes(), nil)
 				if err != nil {
 					return err
 				}
-				if err = os.MkdirAll(filepath.Dir(fn), 0755); err != nil {
-					return err
-				}
-				return ioutil.WriteFile(fn, buf, 0644)
+				b.Reset()
+				_, err = b.Write(buf)
+				return err
 			}
 		}(k))
 	}
@@ -520,6 +1054,28 @@ func pad(s string, n int) string {
 	return s + strings.Repeat(" ", n)
 }
 
+// stringSlice is a flag value for a repeatable string flag.
+type stringSlice []string
+
+// String satisfies the flag.Value interface.
+func (v *stringSlice) String() string {
+	return strings.Join(*v, ",")
+}
+
+// Set satisfies the flag.Value interface.
+func (v *stringSlice) Set(s string) error {
+	*v = append(*v, s)
+	return nil
+}
+
+// stringsFlag defines a repeatable string flag with the specified name and
+// usage.
+func stringsFlag(name, usage string) *stringSlice {
+	v := new(stringSlice)
+	flag.Var(v, name, usage)
+	return v
+}
+
 // whitelisted checks if n is a whitelisted file.
 func whitelisted(n string) bool {
 	for _, z := range strings.Split(*flagGoWl, ",") {
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/chromedp/cdproto-gen/pdl"
+	"github.com/chromedp/cdproto-gen/util"
+)
+
+func TestCombinedFiles(t *testing.T) {
+	defer func(logf func(string, ...interface{})) { util.Logf = logf }(util.Logf)
+	defer func(cache, chromium, v8 string, json bool) {
+		*flagCache, *flagChromium, *flagV8, *flagJSON = cache, chromium, v8, json
+	}(*flagCache, *flagChromium, *flagV8, *flagJSON)
+	util.Logf = t.Logf
+
+	dir, err := ioutil.TempDir("", "cdproto-gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	combinedDir := filepath.Join(dir, "pdl", "combined")
+
+	// write the combined protocol definitions of an untagged v8 revision
+	const rev = "0123456789abcdef0123456789abcdef01234567"
+	p, err := pdl.Parse([]byte("domain A\n  command a\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	*flagCache, *flagChromium, *flagV8, *flagJSON = dir, "140.0.7339.207", rev, false
+	protoFile, err := writeCombined(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := filepath.Join(combinedDir, "140.0.7339.207_"+rev+".pdl"); protoFile != exp {
+		t.Errorf("expected %s, got: %s", exp, protoFile)
+	}
+	for _, name := range []string{
+		"140.0.7339.207_14.0.365.10.pdl",
+		"139.0.7258.154_13.9.205.20.pdl",
+		"140.0.7339.80_14.0.365.4.pdl",
+		"12.0.267_" + strings.ToUpper(rev) + ".pdl",
+		"140.0.7339.207_" + rev[:39] + ".pdl",
+		"140.0.7339.207.pdl",
+		"index.json",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(combinedDir, name), []byte("domain A\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err := combinedFiles(combinedDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range files {
+		names = append(names, filepath.Base(f.Name))
+	}
+	exp := []string{
+		"139.0.7258.154_13.9.205.20.pdl",
+		"140.0.7339.80_14.0.365.4.pdl",
+		"140.0.7339.207_" + rev + ".pdl",
+		"140.0.7339.207_14.0.365.10.pdl",
+	}
+	if s, e := strings.Join(names, " "), strings.Join(exp, " "); s != e {
+		t.Errorf("expected files %s, got: %s", e, s)
+	}
+	if v := combinedVersions(files[2].Name); len(v) != 2 || v[0] != "140.0.7339.207" || v[1] != rev {
+		t.Errorf("expected versions 140.0.7339.207 and %s, got: %v", rev, v)
+	}
+
+	// loaded by the cached lookup, when the v8 version is not specified or is
+	// the revision
+	if err := os.Remove(filepath.Join(combinedDir, "140.0.7339.207_14.0.365.10.pdl")); err != nil {
+		t.Fatal(err)
+	}
+	for _, v8 := range []string{"", "latest", rev} {
+		*flagChromium, *flagV8 = "140.0.7339.207", v8
+		lk := &locker{lock: new(Lock)}
+		p, err := loadOffline(context.Background(), nil, lk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *flagChromium != "140.0.7339.207" || *flagV8 != rev {
+			t.Errorf("-v8 %q expected -chromium 140.0.7339.207 and -v8 %s, got: %s and %s", v8, rev, *flagChromium, *flagV8)
+		}
+		if len(p.Domains) != 1 || len(p.Domains[0].Commands) != 1 {
+			t.Errorf("-v8 %q expected domain A with command a, got:\n%s", v8, p.Bytes())
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package pdl
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// MergeStrategy is a strategy for combining protocol definitions that define
+// the same domain.
+type MergeStrategy int
+
+// MergeStrategy values.
+const (
+	// MergeError reports each domain defined more than once as an error.
+	MergeError MergeStrategy = iota
+
+	// MergeFirstWins keeps the first definition of a domain.
+	MergeFirstWins
+
+	// MergeLastWins keeps the last definition of a domain.
+	MergeLastWins
+
+	// MergeDeep merges the types, commands, events, and members of each
+	// definition of a domain, with later definitions of the same member
+	// replacing earlier ones.
+	MergeDeep
+)
+
+// mergeStrategies are the merge strategy names.
+var mergeStrategies = []string{"error", "first-wins", "last-wins", "deep-merge"}
+
+// String satisfies the fmt.Stringer interface.
+func (s MergeStrategy) String() string {
+	if s < 0 || int(s) >= len(mergeStrategies) {
+		return fmt.Sprintf("MergeStrategy(%d)", int(s))
+	}
+	return mergeStrategies[s]
+}
+
+// ParseMergeStrategy parses a merge strategy name (ie, error, first-wins,
+// last-wins, or deep-merge).
+func ParseMergeStrategy(s string) (MergeStrategy, error) {
+	for i, z := range mergeStrategies {
+		if s == z {
+			return MergeStrategy(i), nil
+		}
+	}
+	return MergeError, fmt.Errorf("invalid merge strategy %q", s)
+}
+
+// MergeAction is how two definitions of the same element were combined.
+type MergeAction string
+
+// MergeAction values.
+const (
+	MergeIdentical MergeAction = "identical"
+	MergeKeptFirst MergeAction = "kept first"
+	MergeKeptLast  MergeAction = "kept last"
+	MergeMerged    MergeAction = "merged"
+	MergeReplaced  MergeAction = "replaced"
+)
+
+// Merge is a domain, type, command, event, or member defined more than once
+// and combined by Combine.
+type Merge struct {
+	// Element is the kind of the element (ie, domain, type, command, event,
+	// property, parameter, or return value).
+	Element string
+
+	// Path is the path of the element (ie, Domain.command.param).
+	Path string
+
+	// Prev is the source position of the earlier definition.
+	Prev Position
+
+	// Pos is the source position of the later definition.
+	Pos Position
+
+	// Action is how the definitions were combined.
+	Action MergeAction
+}
+
+// String satisfies the fmt.Stringer interface.
+func (m Merge) String() string {
+	s := m.Element + " " + m.Path + ": " + string(m.Action)
+	if prev, pos := m.Prev.String(), m.Pos.String(); prev != "" && pos != "" {
+		s += " (defined at " + prev + " and " + pos + ")"
+	}
+	return s
+}
+
+// Combine combines the domains from multiple protocol definitions into a
+// single protocol definition, returning the domains, types, commands, events,
+// and members that were defined more than once, and how they were combined.
+//
+// Identical definitions of a domain are always combined. Otherwise, domains
+// defined more than once are combined according to the strategy, and with
+// MergeError, are returned as a ProblemList. Domains are kept in the order they
+// were first defined.
+//
+// The combined version is the highest version of the protocol definitions.
+func Combine(strategy MergeStrategy, pdls ...*PDL) (*PDL, []Merge, error) {
+	c := &combiner{
+		strategy: strategy,
+		pdl:      new(PDL),
+		domains:  make(map[DomainType]int),
+	}
+	for _, p := range pdls {
+		if c.pdl.Copyright == "" {
+			c.pdl.Copyright = p.Copyright
+		}
+		if c.pdl.Version == nil {
+			c.pdl.Version = new(Version)
+		}
+		if v := p.Version; v != nil && (v.Major > c.pdl.Version.Major || (v.Major == c.pdl.Version.Major && v.Minor > c.pdl.Version.Minor)) {
+			*c.pdl.Version = *v
+		}
+		for _, d := range p.Domains {
+			c.domain(d)
+		}
+	}
+	if len(c.problems) != 0 {
+		return nil, nil, c.problems
+	}
+	return c.pdl, c.merges, nil
+}
+
+// CombineBytes combines domains from multiple PDL definitions into a single
+// PDL, according to the strategy.
+func CombineBytes(strategy MergeStrategy, buffers ...[]byte) ([]byte, error) {
+	var pdls []*PDL
+	for _, buf := range buffers {
+		pdl, err := Parse(buf)
+		if err != nil {
+			return nil, err
+		}
+		pdls = append(pdls, pdl)
+	}
+	pdl, _, err := Combine(strategy, pdls...)
+	if err != nil {
+		return nil, err
+	}
+	return pdl.CanonicalBytes(), nil
+}
+
+// combiner holds the state for combining protocol definitions.
+type combiner struct {
+	strategy MergeStrategy
+	pdl      *PDL
+	domains  map[DomainType]int
+	merges   []Merge
+	problems ProblemList
+}
+
+// merge adds a merge.
+func (c *combiner) merge(element, path string, prev, pos Position, action MergeAction) {
+	c.merges = append(c.merges, Merge{
+		Element: element,
+		Path:    path,
+		Prev:    prev,
+		Pos:     pos,
+		Action:  action,
+	})
+}
+
+// domain adds domain d to the combined protocol definition.
+func (c *combiner) domain(d *Domain) {
+	i, ok := c.domains[d.Domain]
+	if !ok {
+		c.domains[d.Domain] = len(c.pdl.Domains)
+		c.pdl.Domains = append(c.pdl.Domains, d)
+		return
+	}
+
+	prev, path := c.pdl.Domains[i], d.Domain.String()
+	if bytes.Equal(domainBytes(prev), domainBytes(d)) {
+		c.merge("domain", path, prev.Pos, d.Pos, MergeIdentical)
+		return
+	}
+	switch c.strategy {
+	case MergeFirstWins:
+		c.merge("domain", path, prev.Pos, d.Pos, MergeKeptFirst)
+	case MergeLastWins:
+		c.merge("domain", path, prev.Pos, d.Pos, MergeKeptLast)
+		c.pdl.Domains[i] = d
+	case MergeDeep:
+		c.merge("domain", path, prev.Pos, d.Pos, MergeMerged)
+		z := *d
+		if z.Description == "" {
+			z.Description = prev.Description
+		}
+		z.Dependencies = unionStrings(prev.Dependencies, d.Dependencies)
+		z.Types = c.items("type", path, prev.Types, d.Types)
+		z.Commands = c.items("command", path, prev.Commands, d.Commands)
+		z.Events = c.items("event", path, prev.Events, d.Events)
+		c.pdl.Domains[i] = &z
+	default:
+		msg := "domain already defined"
+		if s := prev.Pos.String(); s != "" {
+			msg += " at " + s
+		}
+		c.problems = append(c.problems, Problem{Path: path, Pos: d.Pos, Msg: msg})
+	}
+}
+
+// items deep merges the types, commands, or events as and bs, returning the
+// merged list.
+func (c *combiner) items(element, path string, as, bs []*Type) []*Type {
+	v := append([]*Type(nil), as...)
+	for _, b := range bs {
+		p := path + "." + b.Name
+		i := typeIndex(v, b.Name)
+		switch {
+		case i == -1:
+			v = append(v, b)
+		case bytes.Equal(itemBytes(element, v[i]), itemBytes(element, b)):
+			c.merge(element, p, v[i].Pos, b.Pos, MergeIdentical)
+		default:
+			c.merge(element, p, v[i].Pos, b.Pos, MergeMerged)
+			z := *b
+			z.Enum = unionStrings(v[i].Enum, b.Enum)
+			z.Properties = c.members("property", p, v[i].Properties, b.Properties)
+			z.Parameters = c.members("parameter", p, v[i].Parameters, b.Parameters)
+			z.Returns = c.members("return value", p, v[i].Returns, b.Returns)
+			v[i] = &z
+		}
+	}
+	return v
+}
+
+// members deep merges the properties, parameters, or return values as and bs
+// of an item, returning the merged list.
+func (c *combiner) members(element, path string, as, bs []*Type) []*Type {
+	v := append([]*Type(nil), as...)
+	for _, b := range bs {
+		p := path + "." + b.Name
+		i := typeIndex(v, b.Name)
+		switch {
+		case i == -1:
+			v = append(v, b)
+		case bytes.Equal(itemBytes("command", &Type{Parameters: []*Type{v[i]}}), itemBytes("command", &Type{Parameters: []*Type{b}})):
+			c.merge(element, p, v[i].Pos, b.Pos, MergeIdentical)
+		default:
+			c.merge(element, p, v[i].Pos, b.Pos, MergeReplaced)
+			v[i] = b
+		}
+	}
+	return v
+}
+
+// domainBytes returns the canonical PDL for the domain, for comparing
+// definitions without their source positions.
+func domainBytes(d *Domain) []byte {
+	return (&PDL{Domains: []*Domain{d}}).CanonicalBytes()
+}
+
+// itemBytes returns the canonical PDL for the type, command, or event, for
+// comparing definitions without their source positions.
+func itemBytes(element string, t *Type) []byte {
+	d := new(Domain)
+	switch element {
+	case "type":
+		d.Types = []*Type{t}
+	case "command":
+		d.Commands = []*Type{t}
+	case "event":
+		d.Events = []*Type{t}
+	}
+	return domainBytes(d)
+}
+
+// unionStrings returns the strings of a followed by those of b not in a. The
+// result is nil only when both a and b are nil, so that an empty enum remains
+// distinct from no enum.
+func unionStrings(a, b []string) []string {
+	if a == nil && b == nil {
+		return nil
+	}
+	v := append(make([]string, 0, len(a)+len(b)), a...)
+	for _, s := range b {
+		if !containsString(v, s) {
+			v = append(v, s)
+		}
+	}
+	return v
+}
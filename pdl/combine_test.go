@@ -0,0 +1,177 @@
+package pdl
+
+import (
+	"strings"
+	"testing"
+)
+
+// combineTests are the protocol definitions combined in the combine tests.
+var combineTests = []struct {
+	filename string
+	buf      string
+}{
+	{"a.pdl", `version
+  major 1
+  minor 9
+
+domain A
+  type E extends string
+    enum
+  command x
+    parameters
+      string p
+      integer q
+
+domain B
+  type T extends string
+`},
+	{"b.pdl", `version
+  major 2
+  minor 0
+
+domain A
+  type E extends string
+    enum
+  command x
+    parameters
+      string p
+      optional integer q
+      boolean r
+  command y
+
+domain B
+  type T extends string
+
+domain C
+`},
+}
+
+// parseCombineTests parses the combine test protocol definitions.
+func parseCombineTests(t *testing.T) []*PDL {
+	t.Helper()
+	var pdls []*PDL
+	for _, test := range combineTests {
+		p, err := Parse([]byte(test.buf), WithFilename(test.filename))
+		if err != nil {
+			t.Fatal(err)
+		}
+		pdls = append(pdls, p)
+	}
+	return pdls
+}
+
+func TestCombine(t *testing.T) {
+	tests := []struct {
+		strategy MergeStrategy
+		merges   []string
+		commands string
+	}{
+		{MergeFirstWins, []string{
+			"domain A: kept first (defined at a.pdl:5:1 and b.pdl:5:1)",
+			"domain B: identical (defined at a.pdl:13:1 and b.pdl:15:1)",
+		}, "x"},
+		{MergeLastWins, []string{
+			"domain A: kept last (defined at a.pdl:5:1 and b.pdl:5:1)",
+			"domain B: identical (defined at a.pdl:13:1 and b.pdl:15:1)",
+		}, "x,y"},
+		{MergeDeep, []string{
+			"domain A: merged (defined at a.pdl:5:1 and b.pdl:5:1)",
+			"type A.E: identical (defined at a.pdl:6:3 and b.pdl:6:3)",
+			"command A.x: merged (defined at a.pdl:8:3 and b.pdl:8:3)",
+			"parameter A.x.p: identical (defined at a.pdl:10:7 and b.pdl:10:7)",
+			"parameter A.x.q: replaced (defined at a.pdl:11:7 and b.pdl:11:7)",
+			"domain B: identical (defined at a.pdl:13:1 and b.pdl:15:1)",
+		}, "x,y"},
+	}
+	for _, test := range tests {
+		t.Run(test.strategy.String(), func(t *testing.T) {
+			p, merges, err := Combine(test.strategy, parseCombineTests(t)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p.Version.Major != 2 || p.Version.Minor != 0 {
+				t.Errorf("expected version 2.0, got: %d.%d", p.Version.Major, p.Version.Minor)
+			}
+			if s := joinDomains(domainTypes(p), ","); s != "A,B,C" {
+				t.Errorf("expected domains A,B,C, got: %s", s)
+			}
+			if len(merges) != len(test.merges) {
+				t.Fatalf("expected %d merges, got: %v", len(test.merges), merges)
+			}
+			for i, m := range merges {
+				if s := m.String(); s != test.merges[i] {
+					t.Errorf("merge %d expected %q, got: %q", i, test.merges[i], s)
+				}
+			}
+			var commands []string
+			for _, c := range p.Domains[0].Commands {
+				commands = append(commands, c.Name)
+			}
+			if s := strings.Join(commands, ","); s != test.commands {
+				t.Errorf("expected commands %s, got: %s", test.commands, s)
+			}
+			if typ := p.Domains[0].Types[0]; typ.Enum == nil || len(typ.Enum) != 0 {
+				t.Errorf("expected empty non-nil enum, got: %#v", typ.Enum)
+			}
+		})
+	}
+}
+
+func TestCombineMergeError(t *testing.T) {
+	_, _, err := Combine(MergeError, parseCombineTests(t)...)
+	problems, ok := err.(ProblemList)
+	if !ok {
+		t.Fatalf("expected ProblemList, got: %T %v", err, err)
+	}
+	exp := Problem{
+		Path: "A",
+		Pos:  Position{File: "b.pdl", Line: 5, Column: 1},
+		Msg:  "domain already defined at a.pdl:5:1",
+	}
+	if len(problems) != 1 || problems[0] != exp {
+		t.Fatalf("expected %v, got: %v", exp, problems)
+	}
+	if s := err.Error(); s != "b.pdl:5:1: A: domain already defined at a.pdl:5:1" {
+		t.Errorf("unexpected error string %q", s)
+	}
+}
+
+func TestUnionStrings(t *testing.T) {
+	tests := []struct {
+		a, b, exp []string
+	}{
+		{nil, nil, nil},
+		{[]string{}, nil, []string{}},
+		{nil, []string{}, []string{}},
+		{[]string{}, []string{}, []string{}},
+		{[]string{"a", "b"}, []string{"c", "a"}, []string{"a", "b", "c"}},
+		{nil, []string{"a", "a"}, []string{"a"}},
+	}
+	for i, test := range tests {
+		v := unionStrings(test.a, test.b)
+		if (v == nil) != (test.exp == nil) || strings.Join(v, ",") != strings.Join(test.exp, ",") {
+			t.Errorf("test %d expected %#v, got: %#v", i, test.exp, v)
+		}
+	}
+}
+
+func TestParseMergeStrategy(t *testing.T) {
+	for _, s := range []MergeStrategy{MergeError, MergeFirstWins, MergeLastWins, MergeDeep} {
+		z, err := ParseMergeStrategy(s.String())
+		if err != nil || z != s {
+			t.Errorf("expected %v, got: %v %v", s, z, err)
+		}
+	}
+	if _, err := ParseMergeStrategy("bogus"); err == nil {
+		t.Error("expected error, got none")
+	}
+}
+
+// domainTypes returns the domain names of p.
+func domainTypes(p *PDL) []DomainType {
+	var v []DomainType
+	for _, d := range p.Domains {
+		v = append(v, d.Domain)
+	}
+	return v
+}
@@ -1,30 +1,417 @@
 package pdl
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 )
 
-// circularDeps is the list of types that can cause circular dependency
-// issues.
-var circularDeps = map[string]bool{
-	"browser.browsercontextid": true,
-	"dom.backendnodeid":        true,
-	"dom.backendnode":          true,
-	"dom.nodeid":               true,
-	"dom.node":                 true,
-	"dom.nodetype":             true,
-	"dom.pseudotype":           true,
-	"dom.rgba":                 true,
-	"dom.shadowroottype":       true,
-	"network.loaderid":         true,
-	"network.monotonictime":    true,
-	"network.timesinceepoch":   true,
-	"page.frameid":             true,
-	"page.frame":               true,
-}
-
-// IsCircularDep returns whether or not a type will cause circular dependency
-// issues. Useful for generating Go packages.
-func IsCircularDep(dtyp, typ string) bool {
-	return circularDeps[strings.ToLower(dtyp+"."+typ)]
+// CircularDepOverrides are the default types always moved to the shared
+// package, in addition to the types determined by ResolveCircularDeps, as the
+// fixups applied to the domains expect these types to be shared.
+var CircularDepOverrides = []string{
+	"Browser.BrowserContextID",
+	"DOM.BackendNodeId",
+	"DOM.BackendNode",
+	"DOM.NodeId",
+	"DOM.Node",
+	"DOM.NodeType",
+	"DOM.PseudoType",
+	"DOM.RGBA",
+	"DOM.ShadowRootType",
+	"Network.LoaderId",
+	"Network.MonotonicTime",
+	"Network.TimeSinceEpoch",
+	"Page.FrameId",
+	"Page.Frame",
+}
+
+// CircularDep is a type moved to the shared package to break a circular
+// dependency between the packages generated for the domains.
+type CircularDep struct {
+	// Domain is the domain of the type.
+	Domain DomainType
+
+	// Type is the moved type.
+	Type *Type
+
+	// Reason is why the type was moved.
+	Reason string
+}
+
+// String satisfies the fmt.Stringer interface.
+func (dep CircularDep) String() string {
+	return dep.Domain.String() + "." + dep.Type.Name + ": " + dep.Reason
+}
+
+// ResolveCircularDeps determines the types that need to be moved to a shared
+// package so that the packages generated for the domains do not import each
+// other in a cycle, marking each as IsCircularDep and returning them in the
+// order they were moved. The overrides (ie, CircularDepOverrides) are the raw
+// names of types (ie, DOM.Node), matched case-insensitively, that are always
+// moved.
+//
+// Builds the domain import graph from the type references of the types,
+// commands, and events of each domain, and breaks each cycle in the graph's
+// strongly connected components by moving the types for the import with the
+// fewest types to move. As a shared type cannot import a domain, the types
+// referenced by a shared type are moved as well, as are types already marked
+// as IsCircularDep.
+//
+// The moved set is chosen greedily, one import at a time, and is not
+// necessarily the smallest set of types that breaks every cycle: a cheap
+// import moved early can leave a more expensive one to be moved later, where
+// a different initial choice may have broken both cycles at once. Ties are
+// broken by the first import in domain order, so the result is deterministic.
+//
+// Returns an error when a cycle cannot be broken without moving two types
+// with the same name to the shared package.
+func ResolveCircularDeps(domains []*Domain, overrides []string) ([]CircularDep, error) {
+	g := newDepGraph(domains)
+
+	// add overrides
+	override := make(map[string]bool)
+	for _, name := range overrides {
+		override[strings.ToLower(name)] = true
+	}
+	for _, n := range g.nodes {
+		if n.typ.IsCircularDep || override[strings.ToLower(rawName(n.domain, n.typ))] {
+			g.move(n, "override")
+		}
+	}
+
+	for {
+		// find first cyclic component
+		var scc []DomainType
+		for _, c := range g.sccs() {
+			if len(c) > 1 {
+				scc = c
+				break
+			}
+		}
+		if scc == nil {
+			break
+		}
+
+		// determine the import in the component with the fewest types to move
+		in := make(map[DomainType]bool)
+		for _, d := range scc {
+			in[d] = true
+		}
+		var best *depEdge
+		var bestMove []*depNode
+		for _, e := range g.edges() {
+			if !in[e.from] || !in[e.to] {
+				continue
+			}
+			move := g.closure(e.targets)
+			if g.collides(move) {
+				continue
+			}
+			if best == nil || len(move) < len(bestMove) {
+				best, bestMove = e, move
+			}
+		}
+		if best == nil {
+			return nil, fmt.Errorf("cannot break import cycle between %s: shared type names collide", joinDomains(scc, ", "))
+		}
+
+		// move
+		cycle := g.cycle(best.from, best.to, in)
+		for _, n := range best.targets {
+			g.move(n, fmt.Sprintf("referenced by %s, breaking import cycle %s", best.refs[n], cycle))
+		}
+	}
+
+	// mark
+	for _, dep := range g.deps {
+		dep.Type.IsCircularDep = true
+	}
+	return g.deps, nil
+}
+
+// depNode is a type in the dependency graph.
+type depNode struct {
+	domain DomainType
+	typ    *Type
+	// refs are the types referenced by the type.
+	refs []*depNode
+}
+
+// name returns the fully qualified name of the type.
+func (n *depNode) name() string {
+	return n.domain.String() + "." + n.typ.Name
+}
+
+// depRef is a reference from a domain's type, command, or event to a type.
+type depRef struct {
+	from DomainType
+	// path is the referencing type, command, or event (ie, Domain.command).
+	path string
+	to   *depNode
+	// node is the referencing type, or nil for commands and events.
+	node *depNode
+}
+
+// depGraph is a type dependency graph.
+type depGraph struct {
+	domains []DomainType
+	nodes   []*depNode
+	refs    []depRef
+	moved   map[*depNode]bool
+	names   map[string]*depNode
+	deps    []CircularDep
+}
+
+// newDepGraph builds the type dependency graph for domains.
+func newDepGraph(domains []*Domain) *depGraph {
+	g := &depGraph{
+		moved: make(map[*depNode]bool),
+		names: make(map[string]*depNode),
+	}
+
+	// collect types
+	byName := make(map[string]*depNode)
+	for _, d := range domains {
+		g.domains = append(g.domains, d.Domain)
+		for _, t := range d.Types {
+			n := &depNode{domain: d.Domain, typ: t}
+			g.nodes = append(g.nodes, n)
+			byName[strings.ToLower(rawName(d.Domain, t))] = n
+		}
+	}
+
+	// collect refs
+	resolve := func(d *Domain, t *Type) *depNode {
+		for t.Type == TypeArray && t.Items != nil {
+			t = t.Items
+		}
+		if t.Ref == "" || t.NoResolve || t.NoExpose || strings.HasPrefix(t.Ref, "*") {
+			return nil
+		}
+		ref := t.Ref
+		if !strings.Contains(ref, ".") {
+			ref = d.Domain.String() + "." + ref
+		}
+		return byName[strings.ToLower(ref)]
+	}
+	for _, d := range domains {
+		add := func(from *depNode, item *Type, typs ...[]*Type) {
+			path := d.Domain.String() + "." + item.Name
+			for _, v := range typs {
+				for _, t := range v {
+					to := resolve(d, t)
+					if to == nil {
+						continue
+					}
+					if from != nil {
+						from.refs = append(from.refs, to)
+					}
+					g.refs = append(g.refs, depRef{from: d.Domain, path: path, to: to, node: from})
+				}
+			}
+		}
+		for _, t := range d.Types {
+			add(byName[strings.ToLower(rawName(d.Domain, t))], t, []*Type{t}, t.Properties)
+		}
+		for _, t := range d.Commands {
+			add(nil, t, t.Parameters, t.Returns)
+		}
+		for _, t := range d.Events {
+			add(nil, t, t.Parameters)
+		}
+	}
+
+	return g
+}
+
+// rawName returns the raw name of type t in domain dtyp.
+func rawName(dtyp DomainType, t *Type) string {
+	if t.RawName != "" {
+		return t.RawName
+	}
+	return dtyp.String() + "." + t.Name
+}
+
+// move moves a type and the types it references to the shared package.
+func (g *depGraph) move(n *depNode, reason string) {
+	if g.moved[n] {
+		return
+	}
+	g.moved[n] = true
+	g.names[strings.ToLower(n.typ.Name)] = n
+	g.deps = append(g.deps, CircularDep{
+		Domain: n.domain,
+		Type:   n.typ,
+		Reason: reason,
+	})
+	for _, z := range n.refs {
+		g.move(z, "referenced by shared type "+n.name())
+	}
+}
+
+// closure returns the types not yet moved that need to be moved along with
+// nodes, in order.
+func (g *depGraph) closure(nodes []*depNode) []*depNode {
+	seen := make(map[*depNode]bool)
+	var v []*depNode
+	var walk func(*depNode)
+	walk = func(n *depNode) {
+		if seen[n] || g.moved[n] {
+			return
+		}
+		seen[n] = true
+		v = append(v, n)
+		for _, z := range n.refs {
+			walk(z)
+		}
+	}
+	for _, n := range nodes {
+		walk(n)
+	}
+	return v
+}
+
+// collides determines if moving nodes would cause a name collision in the
+// shared package.
+func (g *depGraph) collides(nodes []*depNode) bool {
+	names := make(map[string]bool)
+	for _, n := range nodes {
+		name := strings.ToLower(n.typ.Name)
+		if names[name] || g.names[name] != nil {
+			return true
+		}
+		names[name] = true
+	}
+	return false
+}
+
+// depEdge is an import of domain to by domain from.
+type depEdge struct {
+	from, to DomainType
+	// targets are the imported types, in order.
+	targets []*depNode
+	// refs are the referencing paths for each target.
+	refs map[*depNode]string
+}
+
+// edges returns the domain imports, sorted by domain.
+func (g *depGraph) edges() []*depEdge {
+	m := make(map[[2]DomainType]*depEdge)
+	var edges []*depEdge
+	for _, r := range g.refs {
+		if r.from == r.to.domain || g.moved[r.to] || (r.node != nil && g.moved[r.node]) {
+			continue
+		}
+		k := [2]DomainType{r.from, r.to.domain}
+		e, ok := m[k]
+		if !ok {
+			e = &depEdge{from: r.from, to: r.to.domain, refs: make(map[*depNode]string)}
+			m[k], edges = e, append(edges, e)
+		}
+		if _, ok := e.refs[r.to]; !ok {
+			e.targets = append(e.targets, r.to)
+			e.refs[r.to] = r.path
+		}
+	}
+	sort.SliceStable(edges, func(i, j int) bool {
+		if edges[i].from != edges[j].from {
+			return edges[i].from < edges[j].from
+		}
+		return edges[i].to < edges[j].to
+	})
+	return edges
+}
+
+// sccs returns the strongly connected components of the domain import graph,
+// with each component's domains sorted, and the components sorted by their
+// first domain.
+func (g *depGraph) sccs() [][]DomainType {
+	adj := make(map[DomainType][]DomainType)
+	for _, e := range g.edges() {
+		adj[e.from] = append(adj[e.from], e.to)
+	}
+
+	// tarjan's algorithm
+	index, low := make(map[DomainType]int), make(map[DomainType]int)
+	onStack := make(map[DomainType]bool)
+	var stack []DomainType
+	var sccs [][]DomainType
+	var strongConnect func(DomainType)
+	strongConnect = func(v DomainType) {
+		index[v], low[v] = len(index), len(index)
+		stack, onStack[v] = append(stack, v), true
+		for _, w := range adj[v] {
+			if _, ok := index[w]; !ok {
+				strongConnect(w)
+				if low[w] < low[v] {
+					low[v] = low[w]
+				}
+			} else if onStack[w] && index[w] < low[v] {
+				low[v] = index[w]
+			}
+		}
+		if low[v] == index[v] {
+			var c []DomainType
+			for {
+				w := stack[len(stack)-1]
+				stack, onStack[w] = stack[:len(stack)-1], false
+				c = append(c, w)
+				if w == v {
+					break
+				}
+			}
+			sort.Slice(c, func(i, j int) bool { return c[i] < c[j] })
+			sccs = append(sccs, c)
+		}
+	}
+	domains := append([]DomainType(nil), g.domains...)
+	sort.Slice(domains, func(i, j int) bool { return domains[i] < domains[j] })
+	for _, d := range domains {
+		if _, ok := index[d]; !ok {
+			strongConnect(d)
+		}
+	}
+	sort.Slice(sccs, func(i, j int) bool { return sccs[i][0] < sccs[j][0] })
+	return sccs
+}
+
+// cycle returns the shortest import cycle through the import of to by from,
+// within the domains in.
+func (g *depGraph) cycle(from, to DomainType, in map[DomainType]bool) string {
+	adj := make(map[DomainType][]DomainType)
+	for _, e := range g.edges() {
+		if in[e.from] && in[e.to] {
+			adj[e.from] = append(adj[e.from], e.to)
+		}
+	}
+
+	// breadth first search from to back to from
+	prev := map[DomainType]DomainType{to: to}
+	for queue := []DomainType{to}; len(queue) != 0; queue = queue[1:] {
+		for _, w := range adj[queue[0]] {
+			if _, ok := prev[w]; !ok {
+				prev[w], queue = queue[0], append(queue, w)
+			}
+		}
+		if _, ok := prev[from]; ok {
+			break
+		}
+	}
+
+	// build cycle from -> to -> ... -> from
+	var path []DomainType
+	for v := from; v != to; v = prev[v] {
+		path = append([]DomainType{v}, path...)
+	}
+	return joinDomains(append([]DomainType{from, to}, path...), " -> ")
+}
+
+// joinDomains joins the domain names with sep.
+func joinDomains(domains []DomainType, sep string) string {
+	v := make([]string, len(domains))
+	for i, d := range domains {
+		v[i] = d.String()
+	}
+	return strings.Join(v, sep)
 }
@@ -0,0 +1,202 @@
+package pdl
+
+import (
+	"testing"
+)
+
+func TestResolveCircularDeps(t *testing.T) {
+	tests := []struct {
+		name      string
+		buf       string
+		overrides []string
+		exp       []string
+		err       string
+	}{
+		{"no cycle", `
+domain A
+  type T extends string
+
+domain B
+  command c
+    parameters
+      A.T t
+`, nil, nil, ""},
+		{"cycle", `
+domain A
+  type T extends string
+  type U extends string
+  command c
+    parameters
+      B.V v
+
+domain B
+  type V extends object
+    properties
+      A.T t
+      A.U u
+  type W extends string
+  event e
+    parameters
+      A.T t
+`, nil, []string{
+			"A.T: referenced by B.V, breaking import cycle B -> A -> B",
+			"A.U: referenced by B.V, breaking import cycle B -> A -> B",
+		}, ""},
+		{"closure", `
+domain A
+  type T extends object
+    properties
+      U u
+  type U extends string
+  command c
+    parameters
+      B.V v
+  command d
+    parameters
+      B.W w
+      B.X x
+
+domain B
+  type V extends string
+  type W extends string
+  type X extends string
+  event e
+    parameters
+      A.T t
+`, nil, []string{
+			"A.T: referenced by B.e, breaking import cycle B -> A -> B",
+			"A.U: referenced by shared type A.T",
+		}, ""},
+		{"override", `
+domain DOM
+  type Node extends object
+    properties
+      Mode mode
+  type Mode extends string
+`, []string{"dom.node"}, []string{
+			"DOM.Node: override",
+			"DOM.Mode: referenced by shared type DOM.Node",
+		}, ""},
+		{"no override", `
+domain DOM
+  type Node extends object
+    properties
+      Mode mode
+  type Mode extends string
+`, nil, nil, ""},
+		{"collision", `
+domain A
+  type T extends object
+    properties
+      B.T t
+
+domain B
+  type T extends object
+    properties
+      A.T t
+`, nil, nil, "cannot break import cycle between A, B: shared type names collide"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := Parse([]byte(test.buf))
+			if err != nil {
+				t.Fatal(err)
+			}
+			deps, err := ResolveCircularDeps(p.Domains, test.overrides)
+			switch {
+			case test.err != "" && (err == nil || err.Error() != test.err):
+				t.Fatalf("expected error %q, got: %v", test.err, err)
+			case test.err != "":
+				return
+			case err != nil:
+				t.Fatal(err)
+			}
+			if len(deps) != len(test.exp) {
+				t.Fatalf("expected %d deps, got: %v", len(test.exp), deps)
+			}
+			for i, dep := range deps {
+				if s := dep.String(); s != test.exp[i] {
+					t.Errorf("dep %d expected %q, got: %q", i, test.exp[i], s)
+				}
+				if !dep.Type.IsCircularDep {
+					t.Errorf("dep %d expected IsCircularDep to be true", i)
+				}
+			}
+			checkNoCycles(t, p.Domains)
+		})
+	}
+}
+
+func TestResolveCircularDepsCombined(t *testing.T) {
+	buf := readCombined(t)
+	p, err := Parse(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deps, err := ResolveCircularDeps(p.Domains, CircularDepOverrides)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkNoCycles(t, p.Domains)
+
+	// every override is moved, along with the types they reference (the
+	// static list of shared types did not include DOM.CompatibilityMode,
+	// which is referenced by DOM.Node)
+	moved := make(map[string]string)
+	for _, dep := range deps {
+		moved[dep.Domain.String()+"."+dep.Type.Name] = dep.Reason
+	}
+	for _, name := range CircularDepOverrides {
+		if name == "DOM.NodeType" {
+			// added by the fixups
+			continue
+		}
+		if _, ok := moved[name]; !ok {
+			t.Errorf("expected override %s to be moved", name)
+		}
+	}
+	if reason, exp := moved["DOM.CompatibilityMode"], "referenced by shared type DOM.Node"; reason != exp {
+		t.Errorf("expected DOM.CompatibilityMode to be %s, got: %q", exp, reason)
+	}
+
+	// without overrides
+	if p, err = Parse(buf); err != nil {
+		t.Fatal(err)
+	}
+	if deps, err = ResolveCircularDeps(p.Domains, nil); err != nil {
+		t.Fatal(err)
+	}
+	checkNoCycles(t, p.Domains)
+	for _, dep := range deps {
+		if dep.Reason == "override" {
+			t.Errorf("expected no overrides, got: %v", dep)
+		}
+	}
+	if len(deps) == 0 {
+		t.Error("expected types to be moved")
+	}
+}
+
+// checkNoCycles checks that every type referenced by a type marked as
+// IsCircularDep is also marked, and that no import cycles remain between
+// the domains once the marked types are moved.
+func checkNoCycles(t *testing.T, domains []*Domain) {
+	t.Helper()
+	g := newDepGraph(domains)
+	for _, n := range g.nodes {
+		if !n.typ.IsCircularDep {
+			continue
+		}
+		for _, z := range n.refs {
+			if !z.typ.IsCircularDep {
+				t.Errorf("shared type %s references %s, which is not shared", n.name(), z.name())
+			}
+		}
+		g.move(n, "")
+	}
+	for _, c := range g.sccs() {
+		if len(c) > 1 {
+			t.Errorf("import cycle remains between %s", joinDomains(c, ", "))
+		}
+	}
+}
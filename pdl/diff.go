@@ -0,0 +1,324 @@
+package pdl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ChangeKind is the kind of a protocol definition change.
+type ChangeKind string
+
+// ChangeKind values.
+const (
+	ChangeAdded    ChangeKind = "added"
+	ChangeRemoved  ChangeKind = "removed"
+	ChangeModified ChangeKind = "changed"
+)
+
+// Change is a change between two protocol definitions.
+type Change struct {
+	// Kind is the kind of change.
+	Kind ChangeKind `json:"kind"`
+
+	// Element is the kind of the changed element (ie, domain, type, command,
+	// event, property, parameter, return value, or enum value).
+	Element string `json:"element"`
+
+	// Path is the path of the changed element (ie, Domain.command.param). For
+	// enum values, the path is that of the enum.
+	Path string `json:"path"`
+
+	// Item is the kind of the type, command, or event containing the changed
+	// member or enum value.
+	Item string `json:"item,omitempty"`
+
+	// Attr is the changed attribute (ie, type, optional, experimental,
+	// deprecated, or redirect) of a modified element.
+	Attr string `json:"attr,omitempty"`
+
+	// Old is the old attribute value, or the removed enum value.
+	Old string `json:"old,omitempty"`
+
+	// New is the new attribute value, or the added enum value.
+	New string `json:"new,omitempty"`
+
+	// OldType is the old definition of the changed type, command, event, or
+	// member, or of the enum for enum values.
+	OldType *Type `json:"-"`
+
+	// NewType is the new definition of the changed type, command, event, or
+	// member, or of the enum for enum values.
+	NewType *Type `json:"-"`
+}
+
+// String satisfies the fmt.Stringer interface.
+func (c Change) String() string {
+	var sign string
+	switch c.Kind {
+	case ChangeAdded:
+		sign = "+"
+	case ChangeRemoved:
+		sign = "-"
+	default:
+		sign = "~"
+	}
+	switch {
+	case c.Element == "enum value" && c.Kind == ChangeAdded:
+		return fmt.Sprintf("%s %s %s: %s", sign, c.Element, c.Path, c.New)
+	case c.Element == "enum value":
+		return fmt.Sprintf("%s %s %s: %s", sign, c.Element, c.Path, c.Old)
+	case c.Kind == ChangeModified:
+		return fmt.Sprintf("%s %s %s: %s %s -> %s", sign, c.Element, c.Path, c.Attr, orNone(c.Old), orNone(c.New))
+	}
+	return fmt.Sprintf("%s %s %s", sign, c.Element, c.Path)
+}
+
+// Changeset is the set of changes between two protocol definitions.
+type Changeset struct {
+	// Old is the old protocol definition.
+	Old *PDL `json:"-"`
+
+	// New is the new protocol definition.
+	New *PDL `json:"-"`
+
+	// Changes are the changes, grouped by domain.
+	Changes []Change `json:"changes"`
+}
+
+// Diff returns the changes to the domains, types, commands, events, members,
+// and enum values between the from and to protocol definitions.
+//
+// Removed elements are listed in their definition order in from, followed by
+// the added and changed elements in their definition order in to. Changes to
+// descriptions are not included.
+func Diff(from, to *PDL) *Changeset {
+	cs := &Changeset{
+		Old: from,
+		New: to,
+	}
+
+	fromDomains, toDomains := domainMap(from), domainMap(to)
+	for _, d := range from.Domains {
+		if _, ok := toDomains[d.Domain]; !ok && fromDomains[d.Domain] == d {
+			cs.add(Change{Kind: ChangeRemoved, Element: "domain", Path: d.Domain.String()})
+		}
+	}
+	for _, d := range to.Domains {
+		if toDomains[d.Domain] != d {
+			continue
+		}
+		z, ok := fromDomains[d.Domain]
+		if !ok {
+			cs.add(Change{Kind: ChangeAdded, Element: "domain", Path: d.Domain.String()})
+			continue
+		}
+		cs.domain(z, d)
+	}
+
+	return cs
+}
+
+// domainMap returns a map of the first definition of each domain in pdl.
+func domainMap(pdl *PDL) map[DomainType]*Domain {
+	m := make(map[DomainType]*Domain, len(pdl.Domains))
+	for _, d := range pdl.Domains {
+		if _, ok := m[d.Domain]; !ok {
+			m[d.Domain] = d
+		}
+	}
+	return m
+}
+
+// add adds a change.
+func (cs *Changeset) add(c Change) {
+	cs.Changes = append(cs.Changes, c)
+}
+
+// attr adds a modification change for attr when the old and new values
+// differ.
+func (cs *Changeset) attr(element, path, attr, old, new string, a, b *Type) {
+	if old != new {
+		cs.add(Change{
+			Kind:    ChangeModified,
+			Element: element,
+			Path:    path,
+			Attr:    attr,
+			Old:     old,
+			New:     new,
+			OldType: a,
+			NewType: b,
+		})
+	}
+}
+
+// domain adds the changes between domains a and b.
+func (cs *Changeset) domain(a, b *Domain) {
+	path := b.Domain.String()
+	cs.attr("domain", path, "experimental", strconv.FormatBool(a.Experimental), strconv.FormatBool(b.Experimental), nil, nil)
+	cs.attr("domain", path, "deprecated", strconv.FormatBool(a.Deprecated), strconv.FormatBool(b.Deprecated), nil, nil)
+	cs.items("type", path, a.Types, b.Types)
+	cs.items("command", path, a.Commands, b.Commands)
+	cs.items("event", path, a.Events, b.Events)
+}
+
+// items adds the changes between the types, commands, or events as and bs.
+func (cs *Changeset) items(element, path string, as, bs []*Type) {
+	for _, a := range as {
+		if findType(bs, a.Name) == nil {
+			cs.add(Change{Kind: ChangeRemoved, Element: element, Path: path + "." + a.Name, OldType: a})
+		}
+	}
+	for _, b := range bs {
+		a := findType(as, b.Name)
+		if a == nil {
+			cs.add(Change{Kind: ChangeAdded, Element: element, Path: path + "." + b.Name, NewType: b})
+			continue
+		}
+		cs.item(element, path+"."+b.Name, a, b)
+	}
+}
+
+// item adds the changes between the type, command, or event definitions a
+// and b.
+func (cs *Changeset) item(element, path string, a, b *Type) {
+	cs.flags(element, path, a, b)
+	if element == "type" {
+		cs.attr(element, path, "type", typeRef(a), typeRef(b), a, b)
+	}
+	cs.attr(element, path, "redirect", redirectString(a.Redirect), redirectString(b.Redirect), a, b)
+	cs.enum(element, path, a, b)
+	cs.members(element, "property", path, a.Properties, b.Properties)
+	cs.members(element, "parameter", path, a.Parameters, b.Parameters)
+	cs.members(element, "return value", path, a.Returns, b.Returns)
+}
+
+// members adds the changes between the properties, parameters, or return
+// values as and bs of an item.
+func (cs *Changeset) members(item, element, path string, as, bs []*Type) {
+	n := len(cs.Changes)
+	for _, a := range as {
+		if findType(bs, a.Name) == nil {
+			cs.add(Change{Kind: ChangeRemoved, Element: element, Path: path + "." + a.Name, OldType: a})
+		}
+	}
+	for _, b := range bs {
+		p := path + "." + b.Name
+		a := findType(as, b.Name)
+		if a == nil {
+			cs.add(Change{Kind: ChangeAdded, Element: element, Path: p, NewType: b})
+			continue
+		}
+		cs.attr(element, p, "type", typeRef(a), typeRef(b), a, b)
+		cs.attr(element, p, "optional", strconv.FormatBool(a.Optional), strconv.FormatBool(b.Optional), a, b)
+		cs.flags(element, p, a, b)
+		cs.enum(item, p, a, b)
+	}
+	for i := n; i < len(cs.Changes); i++ {
+		cs.Changes[i].Item = item
+	}
+}
+
+// flags adds the changes to the experimental and deprecated flags between a
+// and b.
+func (cs *Changeset) flags(element, path string, a, b *Type) {
+	cs.attr(element, path, "experimental", strconv.FormatBool(a.Experimental), strconv.FormatBool(b.Experimental), a, b)
+	cs.attr(element, path, "deprecated", strconv.FormatBool(a.Deprecated), strconv.FormatBool(b.Deprecated), a, b)
+}
+
+// enum adds the changes between the enum values of a and b, contained in an
+// item.
+func (cs *Changeset) enum(item, path string, a, b *Type) {
+	for _, v := range a.Enum {
+		if !containsString(b.Enum, v) {
+			cs.add(Change{Kind: ChangeRemoved, Element: "enum value", Path: path, Item: item, Old: v, OldType: a, NewType: b})
+		}
+	}
+	for _, v := range b.Enum {
+		if !containsString(a.Enum, v) {
+			cs.add(Change{Kind: ChangeAdded, Element: "enum value", Path: path, Item: item, New: v, OldType: a, NewType: b})
+		}
+	}
+}
+
+// Text returns the changes as text, one change per line.
+func (cs *Changeset) Text() []byte {
+	buf := new(bytes.Buffer)
+	for _, c := range cs.Changes {
+		buf.WriteString(c.String() + "\n")
+	}
+	return buf.Bytes()
+}
+
+// JSON returns the changes as JSON.
+func (cs *Changeset) JSON() ([]byte, error) {
+	z := *cs
+	if z.Changes == nil {
+		z.Changes = make([]Change, 0)
+	}
+	buf, err := json.MarshalIndent(z, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, '\n'), nil
+}
+
+// Markdown returns the changes as a Markdown changelog, with a section for
+// each domain.
+func (cs *Changeset) Markdown() []byte {
+	buf := new(bytes.Buffer)
+	buf.WriteString("# Protocol changes\n")
+	if len(cs.Changes) == 0 {
+		buf.WriteString("\nNo changes.\n")
+		return buf.Bytes()
+	}
+
+	var domain string
+	for _, c := range cs.Changes {
+		if d := strings.SplitN(c.Path, ".", 2)[0]; d != domain {
+			domain = d
+			fmt.Fprintf(buf, "\n## %s\n\n", domain)
+		}
+		kind := strings.ToUpper(string(c.Kind[:1])) + string(c.Kind[1:])
+		switch {
+		case c.Element == "enum value" && c.Kind == ChangeAdded:
+			fmt.Fprintf(buf, "- %s %s `%s` to `%s`\n", kind, c.Element, c.New, c.Path)
+		case c.Element == "enum value":
+			fmt.Fprintf(buf, "- %s %s `%s` from `%s`\n", kind, c.Element, c.Old, c.Path)
+		case c.Kind == ChangeModified:
+			fmt.Fprintf(buf, "- %s %s `%s`: %s `%s` → `%s`\n", kind, c.Element, c.Path, c.Attr, orNone(c.Old), orNone(c.New))
+		default:
+			fmt.Fprintf(buf, "- %s %s `%s`\n", kind, c.Element, c.Path)
+		}
+	}
+	return buf.Bytes()
+}
+
+// redirectString returns the string for a redirect, or the empty string if
+// r is nil.
+func redirectString(r *Redirect) string {
+	if r == nil {
+		return ""
+	}
+	return r.String()
+}
+
+// orNone returns s, or "none" when s is empty.
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
+
+// containsString determines if v contains s.
+func containsString(v []string, s string) bool {
+	for _, z := range v {
+		if z == s {
+			return true
+		}
+	}
+	return false
+}
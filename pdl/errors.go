@@ -0,0 +1,52 @@
+package pdl
+
+import (
+	"strings"
+)
+
+// ParseError is a PDL parse error.
+type ParseError struct {
+	// Pos is the source position of the start of the error.
+	Pos Position
+
+	// End is the source position immediately after the end of the error.
+	End Position
+
+	// Msg is the error message.
+	Msg string
+
+	// Expected is a hint for the expected tokens, if any.
+	Expected string
+}
+
+// Error satisfies the error interface.
+func (err *ParseError) Error() string {
+	s := err.Pos.String() + ": " + err.Msg
+	if err.Expected != "" {
+		s += " (expected " + err.Expected + ")"
+	}
+	return s
+}
+
+// ErrorList is a list of parse errors.
+type ErrorList []*ParseError
+
+// Error satisfies the error interface.
+func (l ErrorList) Error() string {
+	s := make([]string, len(l))
+	for i, err := range l {
+		s[i] = err.Error()
+	}
+	return strings.Join(s, "\n")
+}
+
+// add adds err to the list.
+func (l ErrorList) add(err error) ErrorList {
+	switch e := err.(type) {
+	case ErrorList:
+		return append(l, e...)
+	case *ParseError:
+		return append(l, e)
+	}
+	return append(l, &ParseError{Msg: err.Error()})
+}
@@ -0,0 +1,166 @@
+package pdl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		name     string
+		buf      string
+		line     int
+		column   int
+		end      int
+		msg      string
+		expected string
+	}{
+		{"depends on outside of domain", "  depends on A\n", 1, 3, 15, "depends on outside of domain", "domain"},
+		{"type outside of domain", "version\n  type T extends string\n", 2, 3, 24, "type outside of domain", "domain"},
+		{"command outside of domain", "\n  command c\n", 2, 3, 12, "command outside of domain", "domain"},
+		{"event outside of domain", "  experimental event e  \n", 1, 3, 23, "event outside of domain", "domain"},
+		{"member outside of members", "domain A\n  command c\n      string s\n", 3, 7, 15, "member outside of parameters, returns, or properties", "parameters, returns, or properties"},
+		{"members outside of item", "domain A\n    parameters\n", 2, 5, 15, "parameters outside of type, command, or event", "type, command, or event"},
+		{"enum outside of type", "domain A\n    enum\n", 2, 5, 9, "enum outside of type", "type"},
+		{"major outside of version", "  major 1\n", 1, 3, 10, "major outside of version", "version"},
+		{"minor outside of version", "  minor 3\n", 1, 3, 10, "minor outside of version", "version"},
+		{"redirect outside of item", "domain A\n    redirect B\n", 2, 5, 15, "redirect outside of type, command, or event", "type, command, or event"},
+		{"enum literal outside of enum", "domain A\n  command c\n    parameters\n      string s\n        x\n", 5, 9, 10, "enum literal outside of enum", "enum"},
+		{"unknown top level token", "domain A\nbogus\n", 2, 1, 6, `unknown token "bogus"`, "include, version, or domain"},
+		{"unknown domain level token", "domain A\n  bogus thing\n", 2, 3, 14, `unknown token "bogus thing"`, "major, minor, depends on, type, command, or event"},
+		{"unknown item level token", "domain A\n  command c\n    bogus\n", 3, 5, 10, `unknown token "bogus"`, "parameters, returns, properties, enum, or redirect"},
+		{"unknown member level token", "domain A\n  command c\n    parameters\n      a  b\n", 4, 7, 11, `unknown token "a  b"`, "member or enum literal"},
+		{"unknown enum literal level token", "domain A\n  type T extends string\n    enum\n        a b\n", 4, 9, 12, `unknown token "a b"`, "enum literal"},
+		{"odd indent", "domain A\n   type T extends string\n", 2, 4, 25, `unknown token "type T extends string"`, "major, minor, depends on, type, command, or event"},
+		{"odd member indent", "domain A\n  command c\n    parameters\n     string s\n", 4, 6, 14, `unknown token "string s"`, "parameters, returns, properties, enum, or redirect"},
+		{"excessive indent", "domain A\n          x y\n", 2, 11, 14, `unknown token "x y"`, "enum literal"},
+		{"tab indent", "domain A\n\ttype T extends string\n", 2, 2, 23, `unknown token "type T extends string"`, "include, version, or domain"},
+		{"include without file system", "include a.pdl\n", 1, 1, 14, `cannot include "a.pdl": no file system`, ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse([]byte(test.buf), WithFilename("test.pdl"))
+			e, ok := err.(*ParseError)
+			if !ok {
+				t.Fatalf("expected *ParseError, got: %T %v", err, err)
+			}
+			if e.Pos.File != "test.pdl" || e.End.File != "test.pdl" {
+				t.Errorf("expected file test.pdl, got: %q and %q", e.Pos.File, e.End.File)
+			}
+			if e.Pos.Line != test.line || e.End.Line != test.line {
+				t.Errorf("expected line %d, got: %d through %d", test.line, e.Pos.Line, e.End.Line)
+			}
+			if e.Pos.Column != test.column {
+				t.Errorf("expected column %d, got: %d", test.column, e.Pos.Column)
+			}
+			if e.End.Column != test.end {
+				t.Errorf("expected end column %d, got: %d", test.end, e.End.Column)
+			}
+			if e.Msg != test.msg {
+				t.Errorf("expected message %q, got: %q", test.msg, e.Msg)
+			}
+			if e.Expected != test.expected {
+				t.Errorf("expected hint %q, got: %q", test.expected, e.Expected)
+			}
+		})
+	}
+}
+
+func TestParseErrorString(t *testing.T) {
+	_, err := Parse([]byte("domain A\n  bogus\n"), WithFilename("test.pdl"))
+	exp := `test.pdl:2:3: unknown token "bogus" (expected major, minor, depends on, type, command, or event)`
+	if err == nil || err.Error() != exp {
+		t.Errorf("expected %q, got: %v", exp, err)
+	}
+}
+
+func TestWithAllErrors(t *testing.T) {
+	fs := mapFS{
+		"bad.pdl": "domain B\n  bogus\n",
+	}
+	buf := strings.Join([]string{
+		"  depends on A",
+		"domain A",
+		"  command c",
+		"      string s",
+		"include bad.pdl",
+		"    bogus",
+		"  type T extends string",
+	}, "\n")
+
+	// first error only
+	_, err := Parse([]byte(buf), WithFilename("test.pdl"), WithFileSystem(fs))
+	if e, ok := err.(*ParseError); !ok || e.Pos.Line != 1 {
+		t.Fatalf("expected *ParseError on line 1, got: %T %v", err, err)
+	}
+
+	// all errors, including those in included files
+	_, err = Parse([]byte(buf), WithFilename("test.pdl"), WithFileSystem(fs), WithAllErrors())
+	errs, ok := err.(ErrorList)
+	if !ok {
+		t.Fatalf("expected ErrorList, got: %T %v", err, err)
+	}
+	exp := []Position{
+		{"test.pdl", 1, 3},
+		{"test.pdl", 4, 7},
+		{"bad.pdl", 2, 3},
+		{"test.pdl", 6, 5},
+	}
+	if len(errs) != len(exp) {
+		t.Fatalf("expected %d errors, got:\n%v", len(exp), errs)
+	}
+	for i, e := range errs {
+		if e.Pos != exp[i] {
+			t.Errorf("error %d expected position %v, got: %v", i, exp[i], e.Pos)
+		}
+	}
+}
+
+func TestSpans(t *testing.T) {
+	p, err := Parse([]byte(strings.Join([]string{
+		"domain A",
+		"  depends on B",
+		"",
+		"  # T.",
+		"  type T extends object",
+		"    properties",
+		"      string a",
+		"      # An enum.",
+		"      enum e",
+		"        x",
+		"        y",
+		"",
+		"  command c",
+		"    parameters",
+		"      integer n",
+		"",
+		"domain B",
+	}, "\n")), WithFilename("test.pdl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, b := p.Domains[0], p.Domains[1]
+	typ, cmd := a.Types[0], a.Commands[0]
+	tests := []struct {
+		name     string
+		pos, end Position
+		line     int
+		endLine  int
+	}{
+		{"A", a.Pos, a.End, 1, 15},
+		{"A.T", typ.Pos, typ.End, 5, 11},
+		{"A.T.a", typ.Properties[0].Pos, typ.Properties[0].End, 7, 7},
+		{"A.T.e", typ.Properties[1].Pos, typ.Properties[1].End, 9, 11},
+		{"A.c", cmd.Pos, cmd.End, 13, 15},
+		{"A.c.n", cmd.Parameters[0].Pos, cmd.Parameters[0].End, 15, 15},
+		{"B", b.Pos, b.End, 17, 17},
+	}
+	for _, test := range tests {
+		if test.pos.Line != test.line || test.end.Line != test.endLine {
+			t.Errorf("%s expected lines %d through %d, got: %v through %v", test.name, test.line, test.endLine, test.pos, test.end)
+		}
+		if test.pos.File != "test.pdl" {
+			t.Errorf("%s expected file test.pdl, got: %q", test.name, test.pos.File)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package pdl
+
+import (
+	"io/ioutil"
+	"path/filepath"
+)
+
+// FileSystem is the interface for reading files included by a PDL file.
+//
+// Names are slash-separated paths, relative to the file system's root. The
+// parser never reads names outside of the root (ie, starting with ..).
+type FileSystem interface {
+	ReadFile(name string) ([]byte, error)
+}
+
+// Dir is a FileSystem that reads files from a local directory.
+type Dir string
+
+// ReadFile satisfies the FileSystem interface.
+func (d Dir) ReadFile(name string) ([]byte, error) {
+	return ioutil.ReadFile(filepath.Join(string(d), filepath.FromSlash(name)))
+}
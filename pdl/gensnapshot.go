@@ -0,0 +1,127 @@
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/chromedp/cdproto-gen/pdl"
+	"github.com/chromedp/cdproto-gen/util"
+)
+
+var (
+	flagPdl   = flag.String("pdl", "", "combined protocol definitions file (<chromium>_<v8>.pdl) to embed (default: most recent in cache)")
+	flagCache = flag.String("cache", "", "protocol cache directory")
+	flagOut   = flag.String("o", "snapshot.go", "out file")
+)
+
+func main() {
+	flag.Parse()
+
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// combinedRE matches the name of a combined protocol definitions file.
+var combinedRE = regexp.MustCompile(`^([0-9.]+)_([0-9.]+)\.pdl$`)
+
+// run generates the embedded protocol definitions snapshot from a combined
+// protocol definitions file written by cdproto-gen, writing the generated
+// snapshot to flagOut.
+func run() error {
+	name := *flagPdl
+	if name == "" {
+		var err error
+		if name, err = mostRecent(); err != nil {
+			return err
+		}
+	}
+
+	// determine versions
+	m := combinedRE.FindStringSubmatch(filepath.Base(name))
+	if m == nil {
+		return fmt.Errorf("%s is not a combined protocol definitions file (<chromium>_<v8>.pdl)", name)
+	}
+
+	// load and validate
+	log.Printf("SNAPSHOT: %s", name)
+	p, err := pdl.LoadFile(name)
+	if err != nil {
+		return err
+	}
+	for _, problem := range pdl.Validate(p) {
+		if !problem.Warning {
+			return fmt.Errorf("invalid protocol definitions: %v", problem)
+		}
+	}
+
+	// escape
+	pdlBuf := bytes.Replace(p.CanonicalBytes(), []byte("`"), []byte("` + \"`\" + `"), -1)
+	b := new(bytes.Buffer)
+	fmt.Fprintf(b, snapshotTpl, m[1], m[2], string(pdlBuf))
+	return ioutil.WriteFile(*flagOut, b.Bytes(), 0644)
+}
+
+// mostRecent returns the most recent combined protocol definitions file in
+// the cache.
+func mostRecent() (string, error) {
+	if *flagCache == "" {
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return "", err
+		}
+		*flagCache = filepath.Join(cacheDir, "cdproto-gen")
+	}
+	dir := filepath.Join(*flagCache, "pdl", "combined")
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+	var names []string
+	for _, fi := range files {
+		if combinedRE.MatchString(fi.Name()) {
+			names = append(names, fi.Name())
+		}
+	}
+	if len(names) == 0 {
+		return "", errors.New("no combined protocol definitions in cache; run cdproto-gen first")
+	}
+	sort.Slice(names, func(a, b int) bool {
+		n := strings.Split(strings.TrimSuffix(names[a], ".pdl"), "_")
+		m := strings.Split(strings.TrimSuffix(names[b], ".pdl"), "_")
+		if n[0] == m[0] {
+			return util.CompareVersions(n[1], m[1])
+		}
+		return util.CompareVersions(n[0], m[0])
+	})
+	return filepath.Join(dir, names[len(names)-1]), nil
+}
+
+const (
+	snapshotTpl = `package pdl
+
+//go:generate go run gensnapshot.go -o snapshot.go
+
+// Generated by gensnapshot.go. DO NOT EDIT.
+
+// Chromium and V8 versions of the embedded protocol definitions snapshot.
+const (
+	SnapshotChromium = %q
+	SnapshotV8       = %q
+)
+
+// Snapshot is the PDL formatted combined protocol definitions (including HAR)
+// for SnapshotChromium and SnapshotV8, for use when no network is available.
+const Snapshot = ` + "`%s`\n"
+)
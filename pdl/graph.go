@@ -0,0 +1,189 @@
+package pdl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Graph is a domain dependency graph.
+type Graph struct {
+	// Domains are the domains, in definition order.
+	Domains []DomainType `json:"domains"`
+
+	// Dependencies are the declared and actual dependencies between domains,
+	// in definition order.
+	Dependencies []*Dependency `json:"dependencies"`
+}
+
+// Dependency is a dependency of one domain on another.
+type Dependency struct {
+	// From is the dependent domain.
+	From DomainType `json:"from"`
+
+	// To is the domain depended on.
+	To DomainType `json:"to"`
+
+	// Declared indicates the dependency is declared (ie, depends on).
+	Declared bool `json:"declared"`
+
+	// Refs are the types, commands, events, and members of the dependent
+	// domain that reference a type in the domain depended on (ie,
+	// Domain.command.param).
+	Refs []string `json:"refs,omitempty"`
+
+	// Pos is the source position of the dependent domain, or of the first
+	// reference for undeclared dependencies.
+	Pos Position `json:"-"`
+}
+
+// Used determines if the dependency is used by a type reference.
+func (dep *Dependency) Used() bool {
+	return len(dep.Refs) != 0
+}
+
+// NewGraph builds the domain dependency graph for the protocol definition,
+// from both the declared dependencies of each domain and the type references
+// of its types, commands, and events.
+func NewGraph(pdl *PDL) *Graph {
+	g := new(Graph)
+	deps := make(map[[2]DomainType]*Dependency)
+	get := func(from, to DomainType, pos Position) *Dependency {
+		k := [2]DomainType{from, to}
+		if dep, ok := deps[k]; ok {
+			return dep
+		}
+		dep := &Dependency{From: from, To: to, Pos: pos}
+		deps[k] = dep
+		g.Dependencies = append(g.Dependencies, dep)
+		return dep
+	}
+
+	for _, d := range pdl.Domains {
+		g.Domains = append(g.Domains, d.Domain)
+
+		// declared
+		for _, dep := range d.Dependencies {
+			get(d.Domain, DomainType(dep), d.Pos).Declared = true
+		}
+
+		// used
+		ref := func(path string, t *Type) {
+			for t.Type == TypeArray && t.Items != nil {
+				t = t.Items
+			}
+			i := strings.Index(t.Ref, ".")
+			if i == -1 || DomainType(t.Ref[:i]) == d.Domain {
+				return
+			}
+			dep := get(d.Domain, DomainType(t.Ref[:i]), t.Pos)
+			if !dep.Declared && !dep.Used() {
+				dep.Pos = t.Pos
+			}
+			dep.Refs = append(dep.Refs, path)
+		}
+		for _, v := range [][]*Type{d.Types, d.Commands, d.Events} {
+			for _, t := range v {
+				path := d.Domain.String() + "." + t.Name
+				ref(path, t)
+				for _, m := range [][]*Type{t.Properties, t.Parameters, t.Returns} {
+					for _, z := range m {
+						ref(path+"."+z.Name, z)
+					}
+				}
+			}
+		}
+	}
+
+	return g
+}
+
+// Unused returns the declared dependencies not used by any type reference.
+func (g *Graph) Unused() []*Dependency {
+	var v []*Dependency
+	for _, dep := range g.Dependencies {
+		if dep.Declared && !dep.Used() {
+			v = append(v, dep)
+		}
+	}
+	return v
+}
+
+// Undeclared returns the dependencies used by a type reference that are not
+// declared.
+func (g *Graph) Undeclared() []*Dependency {
+	var v []*Dependency
+	for _, dep := range g.Dependencies {
+		if !dep.Declared && dep.Used() {
+			v = append(v, dep)
+		}
+	}
+	return v
+}
+
+// Check returns a warning problem for each declared but unused dependency, and
+// each used but undeclared dependency.
+func (g *Graph) Check() []Problem {
+	var problems []Problem
+	for _, dep := range g.Dependencies {
+		switch {
+		case dep.Declared && !dep.Used():
+			problems = append(problems, Problem{
+				Path:    dep.From.String(),
+				Pos:     dep.Pos,
+				Msg:     "unused dependency " + dep.To.String(),
+				Warning: true,
+			})
+		case !dep.Declared && dep.Used():
+			problems = append(problems, Problem{
+				Path:    dep.Refs[0],
+				Pos:     dep.Pos,
+				Msg:     "undeclared dependency " + dep.To.String(),
+				Warning: true,
+			})
+		}
+	}
+	return problems
+}
+
+// DOT returns the graph in the Graphviz DOT format.
+//
+// Declared and used dependencies are drawn as solid edges, declared but unused
+// dependencies as dashed edges, and undeclared dependencies as red edges.
+func (g *Graph) DOT() []byte {
+	buf := new(bytes.Buffer)
+	buf.WriteString("digraph domains {\n")
+	for _, d := range g.Domains {
+		fmt.Fprintf(buf, "  %s;\n", strconv.Quote(d.String()))
+	}
+	for _, dep := range g.Dependencies {
+		var attrs string
+		switch {
+		case !dep.Used():
+			attrs = " [style=dashed]"
+		case !dep.Declared:
+			attrs = " [color=red]"
+		}
+		fmt.Fprintf(buf, "  %s -> %s%s;\n", strconv.Quote(dep.From.String()), strconv.Quote(dep.To.String()), attrs)
+	}
+	buf.WriteString("}\n")
+	return buf.Bytes()
+}
+
+// JSON returns the graph as JSON.
+func (g *Graph) JSON() ([]byte, error) {
+	z := *g
+	if z.Domains == nil {
+		z.Domains = make([]DomainType, 0)
+	}
+	if z.Dependencies == nil {
+		z.Dependencies = make([]*Dependency, 0)
+	}
+	buf, err := json.MarshalIndent(z, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, '\n'), nil
+}
@@ -0,0 +1,185 @@
+package pdl
+
+import (
+	"strings"
+	"testing"
+)
+
+// graphTest is the protocol definition for the graph tests.
+const graphTest = `domain A
+  depends on B
+  depends on C
+
+  type T extends array of B.T
+  type U extends object
+    properties
+      T t
+      D.T d
+
+  command c
+    parameters
+      array of B.T ts
+    returns
+      D.T d
+
+domain B
+  type T extends string
+  event e
+    parameters
+      A.T t
+
+domain C
+
+domain D
+  depends on A
+  type T extends integer
+`
+
+func TestNewGraph(t *testing.T) {
+	p, err := Parse([]byte(graphTest), WithFilename("test.pdl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := NewGraph(p)
+	if s := joinDomains(g.Domains, ","); s != "A,B,C,D" {
+		t.Errorf("expected domains A,B,C,D, got: %s", s)
+	}
+	exp := []struct {
+		from, to string
+		declared bool
+		refs     string
+		line     int
+	}{
+		{"A", "B", true, "A.T,A.c.ts", 1},
+		{"A", "C", true, "", 1},
+		{"A", "D", false, "A.U.d,A.c.d", 9},
+		{"B", "A", false, "B.e.t", 21},
+		{"D", "A", true, "", 25},
+	}
+	if len(g.Dependencies) != len(exp) {
+		t.Fatalf("expected %d dependencies, got: %d", len(exp), len(g.Dependencies))
+	}
+	for i, dep := range g.Dependencies {
+		e := exp[i]
+		if dep.From.String() != e.from || dep.To.String() != e.to || dep.Declared != e.declared {
+			t.Errorf("dependency %d expected %s -> %s (declared: %t), got: %s -> %s (declared: %t)", i, e.from, e.to, e.declared, dep.From, dep.To, dep.Declared)
+		}
+		if refs := strings.Join(dep.Refs, ","); refs != e.refs {
+			t.Errorf("dependency %d expected refs %q, got: %q", i, e.refs, refs)
+		}
+		if dep.Used() != (e.refs != "") {
+			t.Errorf("dependency %d expected used %t, got: %t", i, e.refs != "", dep.Used())
+		}
+		if dep.Pos.Line != e.line {
+			t.Errorf("dependency %d expected line %d, got: %d", i, e.line, dep.Pos.Line)
+		}
+	}
+
+	// unused and undeclared
+	name := func(v []*Dependency) string {
+		var s []string
+		for _, dep := range v {
+			s = append(s, dep.From.String()+"->"+dep.To.String())
+		}
+		return strings.Join(s, ",")
+	}
+	if s := name(g.Unused()); s != "A->C,D->A" {
+		t.Errorf("expected unused A->C,D->A, got: %s", s)
+	}
+	if s := name(g.Undeclared()); s != "A->D,B->A" {
+		t.Errorf("expected undeclared A->D,B->A, got: %s", s)
+	}
+}
+
+func TestGraphCheck(t *testing.T) {
+	p, err := Parse([]byte(graphTest), WithFilename("test.pdl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []string{
+		"test.pdl:1:1: A: unused dependency C",
+		"test.pdl:9:7: A.U.d: undeclared dependency D",
+		"test.pdl:21:7: B.e.t: undeclared dependency A",
+		"test.pdl:25:1: D: unused dependency A",
+	}
+	problems := NewGraph(p).Check()
+	if len(problems) != len(exp) {
+		t.Fatalf("expected %d problems, got: %v", len(exp), problems)
+	}
+	for i, problem := range problems {
+		if s := problem.Error(); s != exp[i] || !problem.Warning {
+			t.Errorf("problem %d expected warning %q, got: %q (warning: %t)", i, exp[i], s, problem.Warning)
+		}
+	}
+
+	// declared and used dependencies only
+	p, err = Parse([]byte("domain A\n  depends on B\n  type T extends B.T\n\ndomain B\n  type T extends string\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if problems := NewGraph(p).Check(); len(problems) != 0 {
+		t.Errorf("expected no problems, got: %v", problems)
+	}
+}
+
+func TestGraphDOT(t *testing.T) {
+	p, err := Parse([]byte(graphTest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := `digraph domains {
+  "A";
+  "B";
+  "C";
+  "D";
+  "A" -> "B";
+  "A" -> "C" [style=dashed];
+  "A" -> "D" [color=red];
+  "B" -> "A" [color=red];
+  "D" -> "A" [style=dashed];
+}
+`
+	if buf := string(NewGraph(p).DOT()); buf != exp {
+		t.Errorf("expected:\n%s\ngot:\n%s", exp, buf)
+	}
+}
+
+func TestGraphJSON(t *testing.T) {
+	p, err := Parse([]byte("domain A\n  depends on B\n  type T extends B.T\n\ndomain B\n  type T extends string\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf, err := NewGraph(p).JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := `{
+  "domains": [
+    "A",
+    "B"
+  ],
+  "dependencies": [
+    {
+      "from": "A",
+      "to": "B",
+      "declared": true,
+      "refs": [
+        "A.T"
+      ]
+    }
+  ]
+}
+`
+	if string(buf) != exp {
+		t.Errorf("expected:\n%s\ngot:\n%s", exp, buf)
+	}
+
+	// empty
+	buf, err = new(Graph).JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := "{\n  \"domains\": [],\n  \"dependencies\": []\n}\n"; string(buf) != exp {
+		t.Errorf("expected %q, got: %q", exp, buf)
+	}
+}
@@ -0,0 +1,144 @@
+package pdl
+
+// Index is an index of the versions of a sequence of protocol definitions in
+// which each domain, type, command, event, and member (ie, property,
+// parameter, or return value) appears, along with the versions in which each
+// became or stopped being experimental or deprecated.
+type Index struct {
+	// Versions are the indexed versions, in the order added.
+	Versions []string `json:"versions"`
+
+	// Items are the indexed elements, in the order first added.
+	Items []*IndexItem `json:"items"`
+
+	m map[string]*IndexItem
+}
+
+// IndexItem is an indexed protocol definitions element.
+type IndexItem struct {
+	// Element is the kind of the element (ie, domain, type, command, event,
+	// property, parameter, or return value).
+	Element string `json:"element"`
+
+	// Path is the path of the element (ie, Domain.command.param).
+	Path string `json:"path"`
+
+	// Since is the first version in which the element appears.
+	Since string `json:"since"`
+
+	// Until is the last version in which the element appears.
+	Until string `json:"until"`
+
+	// Resumed is the first version of the latest unbroken run of versions in
+	// which the element appears, when the element is absent from any version
+	// between Since and Until.
+	Resumed string `json:"resumed,omitempty"`
+
+	// Experimental and Deprecated are the element's flags in Until.
+	Experimental bool `json:"experimental,omitempty"`
+	Deprecated   bool `json:"deprecated,omitempty"`
+
+	// Changes are the changes to the element's flags, including the flags set
+	// in Since.
+	Changes []IndexChange `json:"changes,omitempty"`
+}
+
+// IndexChange is a change to the experimental or deprecated flag of an
+// indexed element.
+type IndexChange struct {
+	// Version is the first version with the change.
+	Version string `json:"version"`
+
+	// Attr is the changed flag (ie, experimental or deprecated).
+	Attr string `json:"attr"`
+
+	// Value is the flag's new value.
+	Value bool `json:"value"`
+}
+
+// NewIndex creates an empty index.
+func NewIndex() *Index {
+	return &Index{
+		m: make(map[string]*IndexItem),
+	}
+}
+
+// Add adds the protocol definitions of the version to the index. Versions
+// must be added in order, oldest first.
+func (idx *Index) Add(version string, pdl *PDL) {
+	if idx.m == nil {
+		idx.m = make(map[string]*IndexItem, len(idx.Items))
+		for _, item := range idx.Items {
+			idx.m[item.Element+" "+item.Path] = item
+		}
+	}
+	idx.Versions = append(idx.Versions, version)
+	domains := domainMap(pdl)
+	for _, d := range pdl.Domains {
+		if domains[d.Domain] != d {
+			continue
+		}
+		path := d.Domain.String()
+		idx.add(version, "domain", path, d.Experimental, d.Deprecated)
+		idx.items(version, "type", path, d.Types)
+		idx.items(version, "command", path, d.Commands)
+		idx.items(version, "event", path, d.Events)
+	}
+}
+
+// items adds the types, commands, or events of the version.
+func (idx *Index) items(version, element, path string, types []*Type) {
+	for _, t := range types {
+		p := path + "." + t.Name
+		idx.add(version, element, p, t.Experimental, t.Deprecated)
+		idx.members(version, "property", p, t.Properties)
+		idx.members(version, "parameter", p, t.Parameters)
+		idx.members(version, "return value", p, t.Returns)
+	}
+}
+
+// members adds the properties, parameters, or return values of an item of
+// the version.
+func (idx *Index) members(version, element, path string, types []*Type) {
+	for _, t := range types {
+		idx.add(version, element, path+"."+t.Name, t.Experimental, t.Deprecated)
+	}
+}
+
+// add adds an element of the version, recording any changes to its flags.
+func (idx *Index) add(version, element, path string, experimental, deprecated bool) {
+	key := element + " " + path
+	item, ok := idx.m[key]
+	if !ok {
+		item = &IndexItem{
+			Element: element,
+			Path:    path,
+			Since:   version,
+		}
+		idx.m[key] = item
+		idx.Items = append(idx.Items, item)
+	}
+	if experimental != item.Experimental || (!ok && experimental) {
+		item.Changes = append(item.Changes, IndexChange{version, "experimental", experimental})
+	}
+	if deprecated != item.Deprecated || (!ok && deprecated) {
+		item.Changes = append(item.Changes, IndexChange{version, "deprecated", deprecated})
+	}
+	if ok && item.Until != version && item.Until != idx.Versions[len(idx.Versions)-2] {
+		item.Resumed = version
+	}
+	item.Until, item.Experimental, item.Deprecated = version, experimental, deprecated
+}
+
+// Query returns the indexed elements with the path, and the elements they
+// contain (ie, the types, commands, and events of a domain, or the members
+// of a command).
+func (idx *Index) Query(path string) []*IndexItem {
+	var items []*IndexItem
+	for _, item := range idx.Items {
+		if item.Path == path || (len(item.Path) > len(path) && item.Path[:len(path)+1] == path+".") {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,59 @@
+package pdl
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIndex(t *testing.T) {
+	versions := []struct {
+		version string
+		buf     string
+	}{
+		{"1", "domain A\n  command a\n  command b\n  command c\n"},
+		{"2", "domain A\n  experimental command a\n  command c\n"},
+		{"3", "domain A\n  command a\n  command b\n"},
+		{"4", "domain A\n  deprecated command a\n  command b\n  command c\n"},
+	}
+	idx := NewIndex()
+	for _, v := range versions {
+		p, err := Parse([]byte(v.buf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		idx.Add(v.version, p)
+	}
+	if !reflect.DeepEqual(idx.Versions, []string{"1", "2", "3", "4"}) {
+		t.Errorf("expected versions 1 through 4, got: %v", idx.Versions)
+	}
+	exp := []*IndexItem{
+		{Element: "domain", Path: "A", Since: "1", Until: "4"},
+		{Element: "command", Path: "A.a", Since: "1", Until: "4", Deprecated: true, Changes: []IndexChange{
+			{"2", "experimental", true},
+			{"3", "experimental", false},
+			{"4", "deprecated", true},
+		}},
+		{Element: "command", Path: "A.b", Since: "1", Until: "4", Resumed: "3"},
+		{Element: "command", Path: "A.c", Since: "1", Until: "4", Resumed: "4"},
+	}
+	if len(idx.Items) != len(exp) {
+		t.Fatalf("expected %d items, got: %d", len(exp), len(idx.Items))
+	}
+	for i, item := range idx.Items {
+		if !reflect.DeepEqual(item, exp[i]) {
+			t.Errorf("item %d expected %+v, got: %+v", i, exp[i], item)
+		}
+	}
+
+	// query
+	var paths []string
+	for _, item := range idx.Query("A") {
+		paths = append(paths, item.Path)
+	}
+	if !reflect.DeepEqual(paths, []string{"A", "A.a", "A.b", "A.c"}) {
+		t.Errorf("expected A and its commands, got: %v", paths)
+	}
+	if items := idx.Query("A.b"); len(items) != 1 || items[0].Path != "A.b" {
+		t.Errorf("expected A.b, got: %v", items)
+	}
+}
@@ -0,0 +1,432 @@
+package pdl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+)
+
+// ParseJSON parses a JSON protocol definition (ie, protocol.json) contained in
+// buf.
+//
+// The JSON protocol definition format is the format generated by Chromium's
+// convert_protocol_to_json.py, and is the format used by the
+// devtools-protocol repository, the Node.js inspector, and the /json/protocol
+// endpoint of a running browser.
+func ParseJSON(buf []byte) (*PDL, error) {
+	var v jsonProtocol
+	if err := json.Unmarshal(buf, &v); err != nil {
+		return nil, err
+	}
+
+	pdl := new(PDL)
+
+	// version
+	if v.Version != nil {
+		pdl.Version = new(Version)
+		if v.Version.Major != "" {
+			major, err := strconv.Atoi(v.Version.Major.String())
+			if err != nil {
+				return nil, fmt.Errorf("invalid major version %q", v.Version.Major)
+			}
+			pdl.Version.Major = major
+		}
+		if v.Version.Minor != "" {
+			minor, err := strconv.Atoi(v.Version.Minor.String())
+			if err != nil {
+				return nil, fmt.Errorf("invalid minor version %q", v.Version.Minor)
+			}
+			pdl.Version.Minor = minor
+		}
+	}
+
+	// domains
+	for i, raw := range v.Domains {
+		var d jsonDomain
+		if err := json.Unmarshal(raw, &d); err != nil {
+			return nil, err
+		}
+		if d.Domain == "" {
+			return nil, fmt.Errorf("domain %d has no name", i)
+		}
+		keys, err := jsonKeys(raw)
+		if err != nil {
+			return nil, err
+		}
+		events, hasEvents := keys["events"]
+		commands, hasCommands := keys["commands"]
+		domain := &Domain{
+			Domain:       DomainType(d.Domain),
+			Description:  strings.TrimSpace(d.Description),
+			Experimental: d.Experimental,
+			Deprecated:   d.Deprecated,
+			Dependencies: d.Dependencies,
+			eventsFirst:  hasEvents && (!hasCommands || events < commands),
+		}
+		for _, t := range d.Types {
+			domain.Types = append(domain.Types, t.toType(domain.Domain, "type", t.ID))
+		}
+		for _, t := range d.Commands {
+			domain.Commands = append(domain.Commands, t.toType(domain.Domain, "command", t.Name))
+		}
+		for _, t := range d.Events {
+			domain.Events = append(domain.Events, t.toType(domain.Domain, "event", t.Name))
+		}
+		pdl.Domains = append(pdl.Domains, domain)
+	}
+
+	return pdl, nil
+}
+
+// LoadJSONFile loads a JSON protocol definition from the specified filename.
+func LoadJSONFile(filename string) (*PDL, error) {
+	buf, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return ParseJSON(buf)
+}
+
+// jsonKeys returns the position of each key in the JSON object in raw.
+func jsonKeys(raw json.RawMessage) (map[string]int, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]int)
+	for i := 0; dec.More(); i++ {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		keys[tok.(string)] = i
+		var v json.RawMessage
+		if err := dec.Decode(&v); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+// jsonProtocol is the top-level JSON protocol definition.
+type jsonProtocol struct {
+	Version *jsonVersion      `json:"version"`
+	Domains []json.RawMessage `json:"domains"`
+}
+
+// jsonVersion is the JSON protocol version. Chromium writes the major and
+// minor values as strings, while other sources write them as numbers.
+type jsonVersion struct {
+	Major json.Number `json:"major"`
+	Minor json.Number `json:"minor"`
+}
+
+// jsonDomain is a JSON protocol domain.
+type jsonDomain struct {
+	Domain       string      `json:"domain"`
+	Description  string      `json:"description"`
+	Experimental bool        `json:"experimental"`
+	Deprecated   bool        `json:"deprecated"`
+	Dependencies []string    `json:"dependencies"`
+	Types        []*jsonType `json:"types"`
+	Commands     []*jsonType `json:"commands"`
+	Events       []*jsonType `json:"events"`
+}
+
+// jsonType is a JSON protocol type, command, event, or member.
+type jsonType struct {
+	ID           string      `json:"id"`
+	Name         string      `json:"name"`
+	Description  string      `json:"description"`
+	Experimental bool        `json:"experimental"`
+	Deprecated   bool        `json:"deprecated"`
+	Optional     bool        `json:"optional"`
+	Type         string      `json:"type"`
+	Ref          string      `json:"$ref"`
+	Items        *jsonType   `json:"items"`
+	Enum         []string    `json:"enum"`
+	Properties   []*jsonType `json:"properties"`
+	Parameters   []*jsonType `json:"parameters"`
+	Returns      []*jsonType `json:"returns"`
+	Redirect     string      `json:"redirect"`
+}
+
+// toType converts the JSON type to a Type, in the same manner as Parse.
+func (jt *jsonType) toType(dtyp DomainType, rawType, name string) *Type {
+	typ := &Type{
+		RawType:      rawType,
+		RawName:      dtyp.String() + "." + name,
+		Name:         name,
+		Experimental: jt.Experimental,
+		Deprecated:   jt.Deprecated,
+		Description:  strings.TrimSpace(jt.Description),
+		Optional:     jt.Optional,
+		Type:         TypeEnum(jt.Type),
+		Ref:          jt.Ref,
+		Enum:         jt.Enum,
+	}
+	if jt.Items != nil {
+		typ.Items = &Type{
+			Type: TypeEnum(jt.Items.Type),
+			Ref:  jt.Items.Ref,
+		}
+	}
+	if jt.Redirect != "" {
+		typ.Redirect = newRedirect(jt.Redirect, typ.Description)
+	}
+	typ.Properties = jsonMembers(dtyp, jt.Properties)
+	typ.Parameters = jsonMembers(dtyp, jt.Parameters)
+	typ.Returns = jsonMembers(dtyp, jt.Returns)
+	return typ
+}
+
+// jsonMembers converts a list of JSON members, preserving the distinction
+// between a missing and an empty list.
+func jsonMembers(dtyp DomainType, v []*jsonType) []*Type {
+	if v == nil {
+		return nil
+	}
+	members := make([]*Type, 0, len(v))
+	for _, m := range v {
+		members = append(members, m.toType(dtyp, "", m.Name))
+	}
+	return members
+}
+
+// JSON generates the JSON protocol definition for the PDL.
+//
+// The output is byte-for-byte identical to the output of Chromium's
+// convert_protocol_to_json.py without --map_binary_to_string (ie, pdl.py's
+// loads written with json.dump using an indent of 4), and does not include
+// the copyright. Binary types are preserved, such that ParseJSON produces a
+// PDL structurally identical to pdl (excluding source positions).
+//
+// See JSONMapBinary for the JSON published by Chromium.
+func (pdl *PDL) JSON() []byte {
+	return pdl.json(false)
+}
+
+// JSONMapBinary generates the JSON protocol definition for the PDL, mapping
+// binary types to string types.
+//
+// The output is byte-for-byte identical to the output of Chromium's
+// convert_protocol_to_json.py with --map_binary_to_string, which is the JSON
+// published in the devtools-protocol repository and served by the
+// /json/protocol endpoint of a running browser. The descriptions of mapped
+// binary types are suffixed with " (Encoded as a base64 string when passed
+// over JSON)".
+func (pdl *PDL) JSONMapBinary() []byte {
+	return pdl.json(true)
+}
+
+// json generates the JSON protocol definition for the PDL, optionally
+// mapping binary types to string types.
+func (pdl *PDL) json(mapBinary bool) []byte {
+	version := jsonObject{}
+	if pdl.Version != nil {
+		version = jsonObject{
+			{"major", strconv.Itoa(pdl.Version.Major)},
+			{"minor", strconv.Itoa(pdl.Version.Minor)},
+		}
+	}
+
+	domains := make([]interface{}, 0, len(pdl.Domains))
+	for _, d := range pdl.Domains {
+		obj := jsonObject{{"domain", d.Domain.String()}}
+		obj = obj.addFlags(d.Description, d.Experimental, d.Deprecated, false)
+		if d.Dependencies != nil {
+			deps := make([]interface{}, 0, len(d.Dependencies))
+			for _, dep := range d.Dependencies {
+				deps = append(deps, dep)
+			}
+			obj = append(obj, jsonField{"dependencies", deps})
+		}
+		if len(d.Types) != 0 {
+			obj = append(obj, jsonField{"types", jsonTypes(d.Types, "id", mapBinary)})
+		}
+		lists := []struct {
+			key  string
+			typs []*Type
+		}{{"commands", d.Commands}, {"events", d.Events}}
+		if d.eventsFirst {
+			lists[0], lists[1] = lists[1], lists[0]
+		}
+		for _, l := range lists {
+			if len(l.typs) != 0 {
+				obj = append(obj, jsonField{l.key, jsonTypes(l.typs, "name", mapBinary)})
+			}
+		}
+		domains = append(domains, obj)
+	}
+
+	buf := new(bytes.Buffer)
+	writeJSON(buf, jsonObject{
+		{"version", version},
+		{"domains", domains},
+	}, "")
+	return buf.Bytes()
+}
+
+// jsonTypes builds the JSON values for a list of types, using key for the
+// type's name, and optionally mapping binary types to string types.
+func jsonTypes(typs []*Type, key string, mapBinary bool) []interface{} {
+	v := make([]interface{}, 0, len(typs))
+	for _, t := range typs {
+		desc := t.Description
+		if mapBinary && t.Type == TypeBinary && desc != "" {
+			desc += " (Encoded as a base64 string when passed over JSON)"
+		}
+		obj := jsonObject{{key, t.Name}}
+		obj = obj.addFlags(desc, t.Experimental, t.Deprecated, t.Optional)
+		obj = obj.addType(t, mapBinary)
+		if t.Redirect != nil {
+			obj = append(obj, jsonField{"redirect", t.Redirect.Domain.String()})
+		}
+		if t.Enum != nil {
+			enum := make([]interface{}, 0, len(t.Enum))
+			for _, e := range t.Enum {
+				enum = append(enum, e)
+			}
+			obj = append(obj, jsonField{"enum", enum})
+		}
+		if t.Properties != nil {
+			obj = append(obj, jsonField{"properties", jsonTypes(t.Properties, "name", mapBinary)})
+		}
+		if t.Parameters != nil {
+			obj = append(obj, jsonField{"parameters", jsonTypes(t.Parameters, "name", mapBinary)})
+		}
+		if t.Returns != nil {
+			obj = append(obj, jsonField{"returns", jsonTypes(t.Returns, "name", mapBinary)})
+		}
+		v = append(v, obj)
+	}
+	return v
+}
+
+// jsonField is a JSON object field.
+type jsonField struct {
+	key   string
+	value interface{}
+}
+
+// jsonObject is a JSON object with ordered fields.
+type jsonObject []jsonField
+
+// addFlags adds the description, experimental, deprecated, and optional
+// fields, in the same order as pdl.py.
+func (obj jsonObject) addFlags(desc string, experimental, deprecated, optional bool) jsonObject {
+	if desc != "" {
+		obj = append(obj, jsonField{"description", desc})
+	}
+	if experimental {
+		obj = append(obj, jsonField{"experimental", true})
+	}
+	if deprecated {
+		obj = append(obj, jsonField{"deprecated", true})
+	}
+	if optional {
+		obj = append(obj, jsonField{"optional", true})
+	}
+	return obj
+}
+
+// addType adds the type, $ref and items fields for the type, optionally
+// mapping binary types to string types.
+func (obj jsonObject) addType(t *Type, mapBinary bool) jsonObject {
+	switch {
+	case t.Type == TypeArray && t.Items != nil:
+		obj = append(obj, jsonField{"type", TypeArray.String()})
+		obj = append(obj, jsonField{"items", jsonObject{}.addType(t.Items, mapBinary)})
+	case t.Ref != "":
+		obj = append(obj, jsonField{"$ref", t.Ref})
+	case mapBinary && t.Type == TypeBinary:
+		obj = append(obj, jsonField{"type", TypeString.String()})
+	case t.Type != "":
+		obj = append(obj, jsonField{"type", t.Type.String()})
+	}
+	return obj
+}
+
+// writeJSON writes v to buf, formatted identically to Python's json.dump
+// with indent=4 and separators=(',', ': ').
+func writeJSON(buf *bytes.Buffer, v interface{}, indent string) {
+	switch x := v.(type) {
+	case jsonObject:
+		if len(x) == 0 {
+			buf.WriteString("{}")
+			return
+		}
+		buf.WriteString("{")
+		for i, f := range x {
+			if i != 0 {
+				buf.WriteString(",")
+			}
+			buf.WriteString("\n" + indent + "    ")
+			writeJSONString(buf, f.key)
+			buf.WriteString(": ")
+			writeJSON(buf, f.value, indent+"    ")
+		}
+		buf.WriteString("\n" + indent + "}")
+
+	case []interface{}:
+		if len(x) == 0 {
+			buf.WriteString("[]")
+			return
+		}
+		buf.WriteString("[")
+		for i, z := range x {
+			if i != 0 {
+				buf.WriteString(",")
+			}
+			buf.WriteString("\n" + indent + "    ")
+			writeJSON(buf, z, indent+"    ")
+		}
+		buf.WriteString("\n" + indent + "]")
+
+	case string:
+		writeJSONString(buf, x)
+
+	case bool:
+		buf.WriteString(strconv.FormatBool(x))
+
+	default:
+		panic(fmt.Sprintf("unknown json value type %T", v))
+	}
+}
+
+// writeJSONString writes s as a quoted JSON string, escaping all non-ASCII
+// characters as Python's json.dump does with ensure_ascii.
+func writeJSONString(buf *bytes.Buffer, s string) {
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '"':
+			buf.WriteString(`\"`)
+		case r == '\\':
+			buf.WriteString(`\\`)
+		case r == '\n':
+			buf.WriteString(`\n`)
+		case r == '\r':
+			buf.WriteString(`\r`)
+		case r == '\t':
+			buf.WriteString(`\t`)
+		case r == '\b':
+			buf.WriteString(`\b`)
+		case r == '\f':
+			buf.WriteString(`\f`)
+		case r < 0x20:
+			fmt.Fprintf(buf, `\u%04x`, r)
+		case r < 0x7f:
+			buf.WriteRune(r)
+		case r > 0xffff:
+			r -= 0x10000
+			fmt.Fprintf(buf, `\u%04x\u%04x`, 0xd800+(r>>10), 0xdc00+(r&0x3ff))
+		default:
+			fmt.Fprintf(buf, `\u%04x`, r)
+		}
+	}
+	buf.WriteByte('"')
+}
@@ -0,0 +1,139 @@
+package pdl
+
+import (
+	"bytes"
+	"io/ioutil"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestJSONMapBinary(t *testing.T) {
+	p := loadTestdata(t, "devtools-protocol.pdl")
+	exp, err := ioutil.ReadFile("testdata/devtools-protocol.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf := p.JSONMapBinary(); !bytes.Equal(buf, exp) {
+		t.Errorf("JSONMapBinary does not match testdata/devtools-protocol.json:\n%s", firstDiff(buf, exp))
+	}
+
+	// the published json is stable when parsed and generated again
+	q, err := ParseJSON(exp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf := q.JSONMapBinary(); !bytes.Equal(buf, exp) {
+		t.Errorf("ParseJSON(devtools-protocol.json).JSONMapBinary() is not stable:\n%s", firstDiff(buf, exp))
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	for _, name := range []string{"devtools-protocol.pdl", "kitchen-sink.pdl", "include.pdl"} {
+		t.Run(name, func(t *testing.T) {
+			p := loadTestdata(t, name)
+			q, err := ParseJSON(p.JSON())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// the json format has no copyright or redirect comments
+			p.Copyright = ""
+			walkTypes(p, func(typ *Type) {
+				if typ.Redirect != nil {
+					typ.Redirect = newRedirect(typ.Redirect.Domain.String(), typ.Description)
+				}
+			})
+			clearPositions(p)
+			if !reflect.DeepEqual(p, q) {
+				t.Errorf("ParseJSON(p.JSON()) is not structurally equal to p:\n%s", firstDiff(q.Bytes(), p.Bytes()))
+			}
+		})
+	}
+}
+
+func TestParseJSONVersion(t *testing.T) {
+	tests := []struct {
+		buf   string
+		major int
+		minor int
+		err   bool
+	}{
+		{`{"version": {"major": "1", "minor": "3"}, "domains": []}`, 1, 3, false},
+		{`{"version": {"major": 1, "minor": 2}, "domains": []}`, 1, 2, false},
+		{`{"version": {"major": "x", "minor": "3"}, "domains": []}`, 0, 0, true},
+		{`{"version": {"major": "1", "minor": "3"}, "domains": [{}]}`, 0, 0, true},
+	}
+	for i, test := range tests {
+		p, err := ParseJSON([]byte(test.buf))
+		switch {
+		case test.err && err == nil:
+			t.Errorf("test %d expected error", i)
+		case !test.err && err != nil:
+			t.Errorf("test %d expected no error, got: %v", i, err)
+		case !test.err && (p.Version.Major != test.major || p.Version.Minor != test.minor):
+			t.Errorf("test %d expected version %d.%d, got: %d.%d", i, test.major, test.minor, p.Version.Major, p.Version.Minor)
+		}
+	}
+}
+
+// loadTestdata loads the named PDL file from the testdata directory.
+func loadTestdata(t testing.TB, name string) *PDL {
+	t.Helper()
+	p, err := LoadFile("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// walkTypes calls f for each type, command, event, and member (including
+// array items) of the domains in p.
+func walkTypes(p *PDL, f func(*Type)) {
+	var walk func([]*Type)
+	walk = func(typs []*Type) {
+		for _, typ := range typs {
+			f(typ)
+			if typ.Items != nil {
+				walk([]*Type{typ.Items})
+			}
+			walk(typ.Parameters)
+			walk(typ.Returns)
+			walk(typ.Properties)
+		}
+	}
+	for _, d := range p.Domains {
+		walk(d.Types)
+		walk(d.Commands)
+		walk(d.Events)
+	}
+}
+
+// clearPositions clears the source positions of p, for comparison with PDLs
+// from other sources.
+func clearPositions(p *PDL) {
+	for _, d := range p.Domains {
+		d.Pos, d.End = Position{}, Position{}
+	}
+	walkTypes(p, func(typ *Type) {
+		typ.Pos, typ.End = Position{}, Position{}
+	})
+}
+
+// firstDiff returns the first differing line of buf and exp.
+func firstDiff(buf, exp []byte) string {
+	a, b := bytes.Split(buf, []byte("\n")), bytes.Split(exp, []byte("\n"))
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y []byte
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if !bytes.Equal(x, y) {
+			return "line " + strconv.Itoa(i+1) + ":\n got: " + string(x) + "\nwant: " + string(y)
+		}
+	}
+	return "(no differing lines)"
+}
@@ -0,0 +1,268 @@
+package pdl
+
+import (
+	"strings"
+)
+
+// tokenKind is a PDL line token kind.
+type tokenKind int
+
+// tokenKind values.
+const (
+	tokenInvalid tokenKind = iota
+	tokenInclude
+	tokenDomain
+	tokenDepends
+	tokenType
+	tokenCommand
+	tokenEvent
+	tokenMember
+	tokenMembers
+	tokenEnum
+	tokenVersion
+	tokenMajor
+	tokenMinor
+	tokenRedirect
+	tokenEnumLiteral
+)
+
+// token is a lexed PDL line.
+type token struct {
+	typ tokenKind
+
+	// experimental, deprecated, optional, and array are the declaration
+	// modifiers.
+	experimental, deprecated, optional, array bool
+
+	// name is the token's value: the included file, domain, dependency, type,
+	// command, event, or member name, the member list kind (ie, parameters,
+	// returns, or properties), the version number, the redirect domain, or
+	// the enum literal.
+	name string
+
+	// ref is the type or member's type.
+	ref string
+}
+
+// lex lexes a single, non-empty and non-comment PDL line.
+//
+// Accepts exactly the same grammar as the regular expressions in pdl.py from
+// the Chromium source tree. Similar to the regular expressions, only the
+// start of a line is matched, and any trailing text is ignored (except for
+// enum literals).
+func lex(line string) token {
+	switch indent(line) {
+	case 0:
+		return lexTopLevel(line)
+	case 2:
+		return lexDomainLevel(line[2:])
+	case 4:
+		return lexItemLevel(line[4:])
+	case 6:
+		if tok, ok := lexMember(line[6:]); ok {
+			return tok
+		}
+		return lexEnumLiteral(line[6:])
+	case 8:
+		return lexEnumLiteral(line[6:])
+	}
+	return token{}
+}
+
+// lexTopLevel lexes an include, domain, or version line.
+func lexTopLevel(line string) token {
+	if s, ok := cutPrefix(line, "include "); ok {
+		return token{typ: tokenInclude, name: s}
+	}
+	tok := token{typ: tokenDomain}
+	s := line
+	s, tok.experimental = cutPrefix(s, "experimental ")
+	s, tok.deprecated = cutPrefix(s, "deprecated ")
+	if s, ok := cutPrefix(s, "domain "); ok {
+		tok.name = s
+		return tok
+	}
+	if strings.HasPrefix(line, "version") {
+		return token{typ: tokenVersion}
+	}
+	return token{}
+}
+
+// lexDomainLevel lexes a depends on, type, command, event, or version major
+// or minor line, with the indent removed.
+func lexDomainLevel(line string) token {
+	if s, ok := cutPrefix(line, "depends on "); ok {
+		if name := nonSpace(s); name != "" {
+			return token{typ: tokenDepends, name: name}
+		}
+		return token{}
+	}
+
+	// type, command, or event
+	tok := token{}
+	s := line
+	s, tok.experimental = cutPrefix(s, "experimental ")
+	s, tok.deprecated = cutPrefix(s, "deprecated ")
+	if s, ok := cutPrefix(s, "type "); ok {
+		if lexTypeDecl(&tok, s) {
+			return tok
+		}
+	}
+	if z, ok := cutPrefix(s, "command "); ok {
+		tok.typ, tok.name = tokenCommand, z
+		return tok
+	}
+	if z, ok := cutPrefix(s, "event "); ok {
+		tok.typ, tok.name = tokenEvent, z
+		return tok
+	}
+
+	// version major or minor
+	for _, v := range []struct {
+		typ    tokenKind
+		prefix string
+	}{{tokenMajor, "major "}, {tokenMinor, "minor "}} {
+		if s, ok := cutPrefix(line, v.prefix); ok {
+			if n := digits(s); n != "" {
+				return token{typ: v.typ, name: n}
+			}
+		}
+	}
+
+	return token{}
+}
+
+// lexTypeDecl lexes the remainder of a type declaration (ie, '<name> extends
+// [array of ]<ref>'), storing the results in tok.
+//
+// The name is everything up to the last ' extends ' that is followed by a
+// valid type reference.
+func lexTypeDecl(tok *token, s string) bool {
+	const extends = " extends "
+	for i := strings.LastIndex(s, extends); i != -1; i = strings.LastIndex(s[:i+len(extends)-1], extends) {
+		ref, array := s[i+len(extends):], false
+		if z, ok := cutPrefix(ref, "array of "); ok && nonSpace(z) != "" {
+			ref, array = z, true
+		}
+		if ref = nonSpace(ref); ref != "" {
+			tok.typ, tok.name, tok.ref, tok.array = tokenType, s[:i], ref, array
+			return true
+		}
+	}
+	return false
+}
+
+// lexItemLevel lexes a parameters, returns, properties, enum, or redirect
+// line, with the indent removed.
+func lexItemLevel(line string) token {
+	for _, kind := range []string{"parameters", "returns", "properties"} {
+		if strings.HasPrefix(line, kind) {
+			return token{typ: tokenMembers, name: kind}
+		}
+	}
+	if strings.HasPrefix(line, "enum") {
+		return token{typ: tokenEnum}
+	}
+	if s, ok := cutPrefix(line, "redirect "); ok {
+		if name := nonSpace(s); name != "" {
+			return token{typ: tokenRedirect, name: name}
+		}
+	}
+	return token{}
+}
+
+// memberModifiers are the optional member declaration modifiers, in order.
+var memberModifiers = []string{"experimental ", "deprecated ", "optional ", "array of "}
+
+// lexMember lexes a member line (ie, '[modifiers...] <ref> <name>'), with the
+// indent removed.
+func lexMember(line string) (token, bool) {
+	var mods [4]bool
+	if !matchMember(line, 0, &mods) {
+		return token{}, false
+	}
+	tok := token{
+		typ:          tokenMember,
+		experimental: mods[0],
+		deprecated:   mods[1],
+		optional:     mods[2],
+		array:        mods[3],
+	}
+	s := line
+	for i, m := range memberModifiers {
+		if mods[i] {
+			s = s[len(m):]
+		}
+	}
+	tok.ref = nonSpace(s)
+	tok.name = nonSpace(s[len(tok.ref)+1:])
+	return tok, true
+}
+
+// matchMember determines which member modifiers, starting at modifier i, are
+// present in s, preferring the presence of a modifier over its absence in the
+// same manner as a regular expression's optional group.
+func matchMember(s string, i int, mods *[4]bool) bool {
+	if i == len(memberModifiers) {
+		ref := nonSpace(s)
+		return ref != "" && len(s) > len(ref)+1 && s[len(ref)] == ' ' && nonSpace(s[len(ref)+1:]) != ""
+	}
+	if z, ok := cutPrefix(s, memberModifiers[i]); ok {
+		if mods[i] = true; matchMember(z, i+1, mods) {
+			return true
+		}
+	}
+	mods[i] = false
+	return matchMember(s, i+1, mods)
+}
+
+// lexEnumLiteral lexes an enum literal line, with the indent removed.
+func lexEnumLiteral(line string) token {
+	if z, ok := cutPrefix(line, "  "); ok && z != "" && nonSpace(z) == z {
+		return token{typ: tokenEnumLiteral, name: z}
+	}
+	if line != "" && nonSpace(line) == line {
+		return token{typ: tokenEnumLiteral, name: line}
+	}
+	return token{}
+}
+
+// indent returns the number of leading spaces in line.
+func indent(line string) int {
+	i := 0
+	for i < len(line) && line[i] == ' ' {
+		i++
+	}
+	return i
+}
+
+// cutPrefix returns s without prefix and true when s starts with prefix,
+// otherwise s and false.
+func cutPrefix(s, prefix string) (string, bool) {
+	if strings.HasPrefix(s, prefix) {
+		return s[len(prefix):], true
+	}
+	return s, false
+}
+
+// nonSpace returns the leading non-space characters of s, using the same
+// definition of space as a regular expression's \s.
+func nonSpace(s string) string {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case ' ', '\t', '\n', '\f', '\r':
+			return s[:i]
+		}
+	}
+	return s
+}
+
+// digits returns the leading ASCII digits of s.
+func digits(s string) string {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return s[:i]
+		}
+	}
+	return s
+}
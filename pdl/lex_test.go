@@ -0,0 +1,105 @@
+package pdl
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestParseDifferential(t *testing.T) {
+	tests := map[string][]byte{
+		"combined.pdl.gz": readCombined(t),
+	}
+	for _, name := range []string{"kitchen-sink.pdl", "devtools-protocol.pdl"} {
+		buf, err := ioutil.ReadFile(filepath.Join("testdata", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tests[name] = buf
+	}
+	for name, buf := range tests {
+		t.Run(name, func(t *testing.T) {
+			checkDifferential(t, buf)
+		})
+	}
+}
+
+func TestParseDifferentialRandom(t *testing.T) {
+	// lines of the combined protocol definitions, and words to build mutated
+	// lines from
+	lines := strings.Split(string(readCombined(t)), "\n")
+	words := []string{
+		"experimental ", "deprecated ", "optional ", "array of ", "type ",
+		"command ", "event ", "domain ", "extends ", " extends ", "depends on ",
+		"major ", "minor ", "12", "version", "enum", "redirect ", "parameters",
+		"returns", "properties", "  ", " ", "\t", "\r", "\v", "x", "Foo.bar",
+		"'", "array", "string", "# ", "\u00a0",
+	}
+	indents := []int{0, 2, 4, 6, 8, 1, 3, 7}
+
+	n := 2000
+	if testing.Short() {
+		n = 200
+	}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < n; i++ {
+		// build a document from a run of real lines, with some lines mutated
+		start := r.Intn(len(lines) - 100)
+		doc := make([]string, 100)
+		for j := range doc {
+			doc[j] = lines[start+j]
+			if r.Intn(4) != 0 {
+				continue
+			}
+			var sb strings.Builder
+			sb.WriteString(strings.Repeat(" ", indents[r.Intn(len(indents))]))
+			if r.Intn(2) == 0 {
+				sb.WriteString(strings.TrimLeft(doc[j], " "))
+			}
+			for k := r.Intn(6); k > 0; k-- {
+				sb.WriteString(words[r.Intn(len(words))])
+			}
+			doc[j] = sb.String()
+		}
+		buf := []byte(strings.Join(doc, "\n"))
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			if !checkDifferential(t, buf) {
+				t.Logf("document:\n%s", buf)
+			}
+		})
+	}
+}
+
+// checkDifferential checks that Parse and the regexp based parser produce
+// the same result for buf, collecting all errors, returning false when they
+// differ.
+func checkDifferential(t *testing.T, buf []byte) bool {
+	t.Helper()
+	p, err := Parse(buf, WithFilename("test.pdl"), WithAllErrors())
+	q, exp := parseRegexp(buf, "test.pdl", true)
+	switch {
+	case (err == nil) != (exp == nil):
+		t.Errorf("expected error %v, got: %v", exp, err)
+		return false
+	case err != nil:
+		a, b := err.(ErrorList), exp.(ErrorList)
+		if len(a) != len(b) {
+			t.Errorf("expected %d errors, got %d:\n%v\nexpected:\n%v", len(b), len(a), a, b)
+			return false
+		}
+		for i := range a {
+			if a[i].Pos != b[i].Pos || a[i].Msg != b[i].Msg {
+				t.Errorf("error %d expected %v, got: %v", i, b[i], a[i])
+				return false
+			}
+		}
+	case !reflect.DeepEqual(p, q):
+		t.Errorf("expected PDL structurally equal to the regexp based parser:\n%s", firstDiff(p.Bytes(), q.Bytes()))
+		return false
+	}
+	return true
+}
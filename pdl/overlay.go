@@ -0,0 +1,224 @@
+package pdl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// overlayDirective is the description line prefix for overlay directives.
+const overlayDirective = "@overlay"
+
+// Overlay actions.
+const (
+	overlayAdd     = "add"
+	overlayReplace = "replace"
+	overlayRemove  = "remove"
+)
+
+// Overlay applies the overlay protocol definitions to pdl, in order, returning
+// any conflicts as problems. The domains of pdl are modified in place.
+//
+// An overlay is a regular protocol definition whose domains are applied to
+// the domains of pdl with the same name. By default, domains, types, commands,
+// events, and members not defined in pdl are added, and those already defined
+// are merged: the dependencies, members, and enum values of the overlay's
+// definition are added to the existing definition. An existing member having a
+// different type or optionality, or an existing type having a different base
+// type, is reported as a conflict.
+//
+// A description line of '@overlay replace' or '@overlay remove' (ie, a
+// '# @overlay remove' comment line preceding the definition in a PDL file)
+// replaces or removes the existing definition instead. Replacing or removing a
+// definition not defined in pdl is reported as a conflict.
+func Overlay(pdl *PDL, overlays ...*PDL) []Problem {
+	o := new(overlayer)
+	for _, z := range overlays {
+		for _, d := range z.Domains {
+			o.domain(pdl, d)
+		}
+	}
+	return o.problems
+}
+
+// overlayer holds the state for applying overlays.
+type overlayer struct {
+	problems []Problem
+}
+
+// errorf adds a problem.
+func (o *overlayer) errorf(path string, pos Position, format string, args ...interface{}) {
+	o.problems = append(o.problems, Problem{
+		Path: path,
+		Pos:  pos,
+		Msg:  fmt.Sprintf(format, args...),
+	})
+}
+
+// action returns the overlay action for the definition at path, removing any
+// overlay directive from its description.
+func (o *overlayer) action(path string, pos Position, desc *string) string {
+	action := overlayAdd
+	var lines []string
+	for _, line := range strings.Split(*desc, "\n") {
+		s, ok := cutPrefix(strings.TrimSpace(line), overlayDirective)
+		if !ok || (s != "" && s[0] != ' ') {
+			lines = append(lines, line)
+			continue
+		}
+		switch s = strings.TrimSpace(s); s {
+		case overlayAdd, overlayReplace, overlayRemove:
+			action = s
+		default:
+			o.errorf(path, pos, "unknown overlay directive %q", overlayDirective+" "+s)
+		}
+	}
+	*desc = strings.TrimSpace(strings.Join(lines, "\n"))
+	return action
+}
+
+// domain applies the overlay domain d to pdl.
+func (o *overlayer) domain(pdl *PDL, d *Domain) {
+	path := d.Domain.String()
+	action := o.action(path, d.Pos, &d.Description)
+	i := -1
+	for j, z := range pdl.Domains {
+		if z.Domain == d.Domain {
+			i = j
+			break
+		}
+	}
+
+	switch {
+	case action != overlayAdd && i == -1:
+		o.errorf(path, d.Pos, "cannot %s domain: not defined", action)
+	case action == overlayRemove:
+		pdl.Domains = append(pdl.Domains[:i], pdl.Domains[i+1:]...)
+	case action == overlayReplace:
+		o.strip(d)
+		pdl.Domains[i] = d
+	case i == -1:
+		o.strip(d)
+		pdl.Domains = append(pdl.Domains, d)
+	default:
+		z := pdl.Domains[i]
+		for _, dep := range d.Dependencies {
+			if !containsString(z.Dependencies, dep) {
+				z.Dependencies = append(z.Dependencies, dep)
+			}
+		}
+		o.items("type", path, &z.Types, d.Types)
+		o.items("command", path, &z.Commands, d.Commands)
+		o.items("event", path, &z.Events, d.Events)
+	}
+}
+
+// items applies the overlay types, commands, or events typs to the existing
+// definitions v.
+func (o *overlayer) items(element, path string, v *[]*Type, typs []*Type) {
+	for _, t := range typs {
+		p := path + "." + t.Name
+		action := o.action(p, t.Pos, &t.Description)
+		i := typeIndex(*v, t.Name)
+
+		switch {
+		case action != overlayAdd && i == -1:
+			o.errorf(p, t.Pos, "cannot %s %s: not defined", action, element)
+		case action == overlayRemove:
+			*v = append((*v)[:i], (*v)[i+1:]...)
+		case action == overlayReplace:
+			o.stripType(p, t)
+			(*v)[i] = t
+		case i == -1:
+			o.stripType(p, t)
+			*v = append(*v, t)
+		default:
+			z := (*v)[i]
+			if element == "type" && typeRef(z) != typeRef(t) {
+				o.errorf(p, t.Pos, "type %s conflicts with existing type %s defined at %s", typeRef(t), typeRef(z), z.Pos)
+				continue
+			}
+			z.Enum = unionStrings(z.Enum, t.Enum)
+			o.members("property", p, &z.Properties, t.Properties)
+			o.members("parameter", p, &z.Parameters, t.Parameters)
+			o.members("return value", p, &z.Returns, t.Returns)
+		}
+	}
+}
+
+// members applies the overlay properties, parameters, or return values typs
+// to the existing members v of an item.
+func (o *overlayer) members(element, path string, v *[]*Type, typs []*Type) {
+	for _, t := range typs {
+		p := path + "." + t.Name
+		action := o.action(p, t.Pos, &t.Description)
+		i := typeIndex(*v, t.Name)
+
+		switch {
+		case action != overlayAdd && i == -1:
+			o.errorf(p, t.Pos, "cannot %s %s: not defined", action, element)
+		case action == overlayRemove:
+			*v = append((*v)[:i], (*v)[i+1:]...)
+		case action == overlayReplace:
+			(*v)[i] = t
+		case i == -1:
+			*v = append(*v, t)
+		default:
+			z := (*v)[i]
+			if typeRef(z) != typeRef(t) || z.Optional != t.Optional {
+				o.errorf(p, t.Pos, "%s %s conflicts with existing %s %s defined at %s", element, memberString(t), element, memberString(z), z.Pos)
+				continue
+			}
+			z.Enum = unionStrings(z.Enum, t.Enum)
+		}
+	}
+}
+
+// strip removes the overlay directives from the definitions of a domain being
+// added or replaced, reporting any replace or remove directives as conflicts.
+func (o *overlayer) strip(d *Domain) {
+	path := d.Domain.String()
+	for _, v := range [][]*Type{d.Types, d.Commands, d.Events} {
+		for _, t := range v {
+			p := path + "." + t.Name
+			if action := o.action(p, t.Pos, &t.Description); action != overlayAdd {
+				o.errorf(p, t.Pos, "cannot %s: domain %s is being added or replaced", action, path)
+			}
+			o.stripType(p, t)
+		}
+	}
+}
+
+// stripType removes the overlay directives from the members of a type,
+// command, or event being added or replaced, reporting any replace or remove
+// directives as conflicts.
+func (o *overlayer) stripType(path string, t *Type) {
+	for _, v := range [][]*Type{t.Properties, t.Parameters, t.Returns} {
+		for _, m := range v {
+			p := path + "." + m.Name
+			if action := o.action(p, m.Pos, &m.Description); action != overlayAdd {
+				o.errorf(p, m.Pos, "cannot %s: %s is being added or replaced", action, path)
+			}
+		}
+	}
+}
+
+// typeIndex returns the index of the type with name in typs, or -1 if not
+// found.
+func typeIndex(typs []*Type, name string) int {
+	for i, t := range typs {
+		if t.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// memberString returns the member's declared type (ie, 'optional array of
+// string').
+func memberString(t *Type) string {
+	s := typeRef(t)
+	if t.Optional {
+		s = "optional " + s
+	}
+	return s
+}
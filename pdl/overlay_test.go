@@ -0,0 +1,308 @@
+package pdl
+
+import (
+	"testing"
+)
+
+// overlayBase is the protocol definition the overlays in TestOverlay are
+// applied to.
+const overlayBase = `domain A
+
+  type T extends string
+    enum
+      x
+
+  type O extends object
+    properties
+      string s
+
+  command c
+    parameters
+      string p
+
+  event e
+
+domain B
+
+  command b
+`
+
+func TestOverlay(t *testing.T) {
+	tests := []struct {
+		name    string
+		overlay string
+		exp     string
+		err     []Problem
+	}{
+		{"empty", ``, overlayBase, nil},
+		{"add domain", `
+domain C
+  command c
+`, overlayBase + `
+domain C
+
+  command c
+`, nil},
+		{"add items", `
+domain A
+  depends on B
+  type U extends integer
+  command d
+  event f
+`, `domain A
+  depends on B
+
+  type T extends string
+    enum
+      x
+
+  type O extends object
+    properties
+      string s
+
+  type U extends integer
+
+  command c
+    parameters
+      string p
+
+  command d
+
+  event e
+
+  event f
+
+domain B
+
+  command b
+`, nil},
+		{"merge", `
+domain A
+  type T extends string
+    enum
+      x
+      y
+  type O extends object
+    properties
+      optional integer i
+  command c
+    parameters
+      string p
+      optional boolean q
+    returns
+      string r
+`, `domain A
+
+  type T extends string
+    enum
+      x
+      y
+
+  type O extends object
+    properties
+      string s
+      optional integer i
+
+  command c
+    parameters
+      string p
+      optional boolean q
+    returns
+      string r
+
+  event e
+
+domain B
+
+  command b
+`, nil},
+		{"replace", `
+domain A
+  # @overlay replace
+  # Replaced.
+  command c
+    parameters
+      integer p
+  type O extends object
+    properties
+      # @overlay replace
+      optional integer s
+
+# @overlay replace
+domain B
+  command z
+`, `domain A
+
+  type T extends string
+    enum
+      x
+
+  type O extends object
+    properties
+      optional integer s
+
+  # Replaced.
+  command c
+    parameters
+      integer p
+
+  event e
+
+domain B
+
+  command z
+`, nil},
+		{"remove", `
+domain A
+  type O extends object
+    properties
+      # @overlay remove
+      string s
+  # @overlay remove
+  event e
+
+# @overlay remove
+domain B
+`, `domain A
+
+  type T extends string
+    enum
+      x
+
+  type O extends object
+    properties
+
+  command c
+    parameters
+      string p
+`, nil},
+		{"not defined", `
+# @overlay replace
+domain C
+
+domain A
+  # @overlay remove
+  command x
+  command c
+    parameters
+      # @overlay replace
+      string q
+`, overlayBase, []Problem{
+			{Path: "C", Pos: Position{File: "overlay.pdl", Line: 3, Column: 1}, Msg: "cannot replace domain: not defined"},
+			{Path: "A.x", Pos: Position{File: "overlay.pdl", Line: 7, Column: 3}, Msg: "cannot remove command: not defined"},
+			{Path: "A.c.q", Pos: Position{File: "overlay.pdl", Line: 11, Column: 7}, Msg: "cannot replace parameter: not defined"},
+		}},
+		{"conflict", `
+domain A
+  type T extends integer
+  type O extends object
+    properties
+      integer s
+  command c
+    parameters
+      optional string p
+`, overlayBase, []Problem{
+			{Path: "A.T", Pos: Position{File: "overlay.pdl", Line: 3, Column: 3}, Msg: "type integer conflicts with existing type string defined at base.pdl:3:3"},
+			{Path: "A.O.s", Pos: Position{File: "overlay.pdl", Line: 6, Column: 7}, Msg: "property integer conflicts with existing property string defined at base.pdl:9:7"},
+			{Path: "A.c.p", Pos: Position{File: "overlay.pdl", Line: 9, Column: 7}, Msg: "parameter optional string conflicts with existing parameter string defined at base.pdl:13:7"},
+		}},
+		{"directive in added definition", `
+domain C
+  # @overlay remove
+  command c
+
+domain A
+  command d
+    parameters
+      # @overlay replace
+      string p
+`, `domain A
+
+  type T extends string
+    enum
+      x
+
+  type O extends object
+    properties
+      string s
+
+  command c
+    parameters
+      string p
+
+  command d
+    parameters
+      string p
+
+  event e
+
+domain B
+
+  command b
+
+domain C
+
+  command c
+`, []Problem{
+			{Path: "C.c", Pos: Position{File: "overlay.pdl", Line: 4, Column: 3}, Msg: "cannot remove: domain C is being added or replaced"},
+			{Path: "A.d.p", Pos: Position{File: "overlay.pdl", Line: 10, Column: 7}, Msg: "cannot replace: A.d is being added or replaced"},
+		}},
+		{"unknown directive", `
+domain A
+  # @overlay rename
+  command c
+`, overlayBase, []Problem{
+			{Path: "A.c", Pos: Position{File: "overlay.pdl", Line: 4, Column: 3}, Msg: `unknown overlay directive "@overlay rename"`},
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := Parse([]byte(overlayBase), WithFilename("base.pdl"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			overlay, err := Parse([]byte(test.overlay), WithFilename("overlay.pdl"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			problems := Overlay(p, overlay)
+			if len(problems) != len(test.err) {
+				t.Fatalf("expected %d problems, got: %v", len(test.err), problems)
+			}
+			for i, problem := range problems {
+				if problem != test.err[i] {
+					t.Errorf("problem %d expected %v, got: %v", i, test.err[i], problem)
+				}
+			}
+			if buf := p.Bytes(); string(buf) != test.exp {
+				t.Errorf("expected:\n%s\ngot:\n%s", test.exp, buf)
+			}
+		})
+	}
+}
+
+func TestOverlayOrder(t *testing.T) {
+	p, err := Parse([]byte(overlayBase))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// later overlays apply to the result of earlier overlays
+	var overlays []*PDL
+	for _, buf := range []string{
+		"domain C\n  command c\n",
+		"domain C\n  # @overlay remove\n  command c\n  command d\n",
+	} {
+		overlay, err := Parse([]byte(buf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		overlays = append(overlays, overlay)
+	}
+	if problems := Overlay(p, overlays...); len(problems) != 0 {
+		t.Fatalf("expected no problems, got: %v", problems)
+	}
+	if exp := overlayBase + "\ndomain C\n\n  command d\n"; string(p.Bytes()) != exp {
+		t.Errorf("expected:\n%s\ngot:\n%s", exp, p.Bytes())
+	}
+}
@@ -5,8 +5,10 @@ package pdl
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"io/ioutil"
-	"regexp"
+	"path"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -26,208 +28,399 @@ type PDL struct {
 	Domains []*Domain
 }
 
+// Option is a PDL parser option.
+type Option func(*parser)
+
+// WithFileSystem is a PDL parser option to set the file system used to
+// resolve include directives.
+func WithFileSystem(fs FileSystem) Option {
+	return func(p *parser) {
+		p.fs = fs
+	}
+}
+
+// WithFilename is a PDL parser option to set the name of the file being
+// parsed. Included files are resolved relative to the file's directory.
+func WithFilename(filename string) Option {
+	return func(p *parser) {
+		p.filename = filename
+	}
+}
+
+// WithAllErrors is a PDL parser option to collect all parse errors, instead
+// of stopping at the first. When any errors are encountered, the returned
+// error will be an ErrorList.
+func WithAllErrors() Option {
+	return func(p *parser) {
+		p.allErrors = true
+	}
+}
+
+// parser holds the PDL parser options.
+type parser struct {
+	// fs is the file system for included files.
+	fs FileSystem
+
+	// filename is the name of the file being parsed.
+	filename string
+
+	// parents are the names of the files including the file being parsed.
+	parents []string
+
+	// allErrors toggles collecting all errors.
+	allErrors bool
+}
+
 // Parse parses a PDL file contained in buf.
 //
 // Rewrite of the Python script from the Chromium source tree.
 //
 // See: $CHROMIUM_SOURCE/third_party/inspector_protocol/pdl.py
 // Rev: a42a629f67ac9aae0aaa8fbd912c654559c5d880
-func Parse(buf []byte) (*PDL, error) {
-	// regexp's copied from pdl.py in the chromium source tree.
-	var (
-		domainRE          = regexp.MustCompile(`^(experimental )?(deprecated )?domain (.*)`)
-		dependsRE         = regexp.MustCompile(`^  depends on ([^\s]+)`)
-		typeRE            = regexp.MustCompile(`^  (experimental )?(deprecated )?type (.*) extends (array of )?([^\s]+)`)
-		commandEventRE    = regexp.MustCompile(`^  (experimental )?(deprecated )?(command|event) (.*)`)
-		memberRE          = regexp.MustCompile(`^      (experimental )?(deprecated )?(optional )?(array of )?([^\s]+) ([^\s]+)`)
-		paramsRetsPropsRE = regexp.MustCompile(`^    (parameters|returns|properties)`)
-		enumRE            = regexp.MustCompile(`^    enum`)
-		versionRE         = regexp.MustCompile(`^version`)
-		majorRE           = regexp.MustCompile(`^  major (\d+)`)
-		minorRE           = regexp.MustCompile(`^  minor (\d+)`)
-		redirectRE        = regexp.MustCompile(`^    redirect ([^\s]+)`)
-		redirectCommentRE = regexp.MustCompile(`^Use '([^']+)' instead$`)
-		enumLiteralRE     = regexp.MustCompile(`^      (  )?[^\s]+$`)
-	)
-
-	pdl := new(PDL)
-
-	// state objects
-	var domain *Domain
-	var item *Type
-	var subitems *[]*Type
-	var enumliterals *[]string
-	var desc string
-	var copyright, clearDesc bool
+func Parse(buf []byte, opts ...Option) (*PDL, error) {
+	p := new(parser)
+	for _, o := range opts {
+		o(p)
+	}
+	return p.parse(buf)
+}
+
+// ParseReader parses a PDL file read from r.
+func ParseReader(r io.Reader, opts ...Option) (*PDL, error) {
+	buf, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(buf, opts...)
+}
 
+// parse parses the PDL contained in buf.
+func (p *parser) parse(buf []byte) (*PDL, error) {
+	s := &state{
+		pdl: new(PDL),
+	}
+	var errs ErrorList
 	for i, line := range strings.Split(string(buf), "\n") {
-		// clear the description if toggled
-		if clearDesc {
-			desc, clearDesc = "", false
+		if err := p.line(s, i, line); err != nil {
+			if !p.allErrors {
+				return nil, err
+			}
+			errs = errs.add(err)
 		}
+	}
+	if len(errs) != 0 {
+		return nil, errs
+	}
+	return s.pdl, nil
+}
 
-		// trim the line
-		trimmed := strings.TrimSpace(line)
+// state holds the PDL parser state.
+type state struct {
+	pdl          *PDL
+	domain       *Domain
+	item         *Type
+	member       *Type
+	subitems     *[]*Type
+	enumliterals *[]string
+	desc         string
+	copyright    bool
+	clearDesc    bool
+}
 
-		// add to desc
-		if strings.HasPrefix(trimmed, "#") {
-			if len(desc) != 0 {
-				desc += "\n"
-			}
-			desc += strings.TrimSpace(trimmed[1:])
-			continue
-		} else {
-			if !copyright {
-				copyright, pdl.Copyright = true, desc
-			}
-			clearDesc = true
+// extend extends the source span of the current domain, item, and member
+// to pos.
+func (s *state) extend(pos Position) {
+	if s.domain != nil {
+		s.domain.End = pos
+	}
+	if s.item != nil {
+		s.item.End = pos
+	}
+	if s.member != nil {
+		s.member.End = pos
+	}
+}
+
+// line parses the line with the zero-based line index i.
+func (p *parser) line(s *state, i int, line string) error {
+	// clear the description if toggled
+	if s.clearDesc {
+		s.desc, s.clearDesc = "", false
+	}
+
+	// trim the line
+	trimmed := strings.TrimSpace(line)
+
+	// add to desc
+	if strings.HasPrefix(trimmed, "#") {
+		if len(s.desc) != 0 {
+			s.desc += "\n"
 		}
+		s.desc += strings.TrimPrefix(trimmed[1:], " ")
+		return nil
+	}
 
-		// skip empty line
+	// the copyright is the leading comment, when followed by an empty line
+	if !s.copyright {
+		s.copyright = true
 		if len(trimmed) == 0 {
-			continue
+			s.pdl.Copyright = s.desc
 		}
+	}
+	s.clearDesc = true
 
-		// domain
-		if matches := domainRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
-			domain = &Domain{
-				Domain:       DomainType(matches[0][3]),
-				Experimental: matches[0][1] != "",
-				Deprecated:   matches[0][2] != "",
-				Description:  strings.TrimSpace(desc),
-			}
-			pdl.Domains = append(pdl.Domains, domain)
-			continue
-		}
-
-		// dependencies
-		if matches := dependsRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
-			domain.Dependencies = append(domain.Dependencies, matches[0][1])
-			continue
-		}
-
-		// type
-		if matches := typeRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
-			item = &Type{
-				RawType:       "type",
-				RawName:       domain.Domain.String() + "." + matches[0][3],
-				IsCircularDep: IsCircularDep(domain.Domain.String(), matches[0][3]),
-				Name:          matches[0][3],
-				Experimental:  matches[0][1] != "",
-				Deprecated:    matches[0][2] != "",
-				Description:   strings.TrimSpace(desc),
-			}
-			assignType(item, matches[0][5], matches[0][4] != "")
-			domain.Types = append(domain.Types, item)
-			continue
-		}
-
-		// command or event
-		if matches := commandEventRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
-			item = &Type{
-				RawName:       domain.Domain.String() + "." + matches[0][4],
-				IsCircularDep: IsCircularDep(domain.Domain.String(), matches[0][4]),
-				Name:          matches[0][4],
-				Experimental:  matches[0][1] != "",
-				Deprecated:    matches[0][2] != "",
-				Description:   strings.TrimSpace(desc),
-			}
-			if matches[0][3] == "command" {
-				item.RawType = "command"
-				domain.Commands = append(domain.Commands, item)
-			} else {
-				item.RawType = "event"
-				domain.Events = append(domain.Events, item)
-			}
-			continue
-		}
-
-		// member to params / returns / properties
-		if matches := memberRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
-			param := &Type{
-				RawName:       domain.Domain.String() + "." + matches[0][6],
-				IsCircularDep: IsCircularDep(domain.Domain.String(), matches[0][6]),
-				Name:          matches[0][6],
-				Experimental:  matches[0][1] != "",
-				Deprecated:    matches[0][2] != "",
-				Description:   strings.TrimSpace(desc),
-				Optional:      matches[0][3] != "",
-			}
-			assignType(param, matches[0][5], matches[0][4] != "")
-			if matches[0][5] == "enum" {
-				param.Enum = make([]string, 0)
-				enumliterals = &param.Enum
-			}
-			*subitems = append(*subitems, param)
-			continue
-		}
-
-		// parameters, returns, properties definition
-		if matches := paramsRetsPropsRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
-			switch matches[0][1] {
-			case "parameters":
-				item.Parameters = make([]*Type, 0)
-				subitems = &item.Parameters
-			case "returns":
-				item.Returns = make([]*Type, 0)
-				subitems = &item.Returns
-			case "properties":
-				item.Properties = make([]*Type, 0)
-				subitems = &item.Properties
-			}
-			continue
+	// skip empty line
+	if len(trimmed) == 0 {
+		return nil
+	}
+
+	pos, end := p.span(i, line)
+	desc := strings.TrimSpace(s.desc)
+
+	tok := lex(line)
+	switch tok.typ {
+	case tokenInclude:
+		included, err := p.include(pos, end, tok.name)
+		if err != nil {
+			return err
+		}
+		s.pdl.Domains = append(s.pdl.Domains, included.Domains...)
+		return nil
+
+	case tokenDomain:
+		s.domain = &Domain{
+			Pos:          pos,
+			Domain:       DomainType(tok.name),
+			Experimental: tok.experimental,
+			Deprecated:   tok.deprecated,
+			Description:  desc,
+		}
+		s.item, s.member, s.subitems, s.enumliterals = nil, nil, nil, nil
+		s.pdl.Domains = append(s.pdl.Domains, s.domain)
+
+	case tokenDepends:
+		if s.domain == nil {
+			return p.errorf(pos, end, "domain", "depends on outside of domain")
+		}
+		s.domain.Dependencies = append(s.domain.Dependencies, tok.name)
+
+	case tokenType:
+		if s.domain == nil {
+			return p.errorf(pos, end, "domain", "type outside of domain")
+		}
+		s.item = &Type{
+			Pos:          pos,
+			RawType:      "type",
+			RawName:      s.domain.Domain.String() + "." + tok.name,
+			Name:         tok.name,
+			Experimental: tok.experimental,
+			Deprecated:   tok.deprecated,
+			Description:  desc,
+		}
+		assignType(s.item, tok.ref, tok.array)
+		s.member, s.subitems, s.enumliterals = nil, nil, nil
+		s.domain.Types = append(s.domain.Types, s.item)
+
+	case tokenCommand, tokenEvent:
+		kind := "command"
+		if tok.typ == tokenEvent {
+			kind = "event"
+		}
+		if s.domain == nil {
+			return p.errorf(pos, end, "domain", "%s outside of domain", kind)
+		}
+		s.item = &Type{
+			Pos:          pos,
+			RawType:      kind,
+			RawName:      s.domain.Domain.String() + "." + tok.name,
+			Name:         tok.name,
+			Experimental: tok.experimental,
+			Deprecated:   tok.deprecated,
+			Description:  desc,
+		}
+		if tok.typ == tokenCommand {
+			s.domain.Commands = append(s.domain.Commands, s.item)
+		} else {
+			s.domain.Events = append(s.domain.Events, s.item)
+			s.domain.eventsFirst = s.domain.eventsFirst || len(s.domain.Commands) == 0
+		}
+		s.member, s.subitems, s.enumliterals = nil, nil, nil
+
+	case tokenMember:
+		if s.subitems == nil {
+			return p.errorf(pos, end, "parameters, returns, or properties", "member outside of parameters, returns, or properties")
+		}
+		s.member = &Type{
+			Pos:          pos,
+			RawName:      s.domain.Domain.String() + "." + tok.name,
+			Name:         tok.name,
+			Experimental: tok.experimental,
+			Deprecated:   tok.deprecated,
+			Description:  desc,
+			Optional:     tok.optional,
+		}
+		assignType(s.member, tok.ref, tok.array)
+		s.enumliterals = nil
+		if tok.ref == "enum" {
+			s.member.Enum = make([]string, 0)
+			s.enumliterals = &s.member.Enum
 		}
+		*s.subitems = append(*s.subitems, s.member)
 
-		// enum
-		if matches := enumRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
-			item.Enum = make([]string, 0)
-			enumliterals = &item.Enum
-			continue
+	case tokenMembers:
+		if s.item == nil {
+			return p.errorf(pos, end, "type, command, or event", "%s outside of type, command, or event", tok.name)
 		}
+		switch tok.name {
+		case "parameters":
+			s.item.Parameters = make([]*Type, 0)
+			s.subitems = &s.item.Parameters
+		case "returns":
+			s.item.Returns = make([]*Type, 0)
+			s.subitems = &s.item.Returns
+		case "properties":
+			s.item.Properties = make([]*Type, 0)
+			s.subitems = &s.item.Properties
+		}
+		s.member, s.enumliterals = nil, nil
 
-		// version
-		if matches := versionRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
-			pdl.Version = new(Version)
-			continue
+	case tokenEnum:
+		if s.item == nil {
+			return p.errorf(pos, end, "type", "enum outside of type")
 		}
+		s.item.Enum = make([]string, 0)
+		s.member, s.subitems, s.enumliterals = nil, nil, &s.item.Enum
+
+	case tokenVersion:
+		s.pdl.Version = new(Version)
+		return nil
 
-		// version major
-		if matches := majorRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
-			pdl.Version.Major, _ = strconv.Atoi(matches[0][1])
-			continue
+	case tokenMajor:
+		if s.pdl.Version == nil {
+			return p.errorf(pos, end, "version", "major outside of version")
 		}
+		s.pdl.Version.Major, _ = strconv.Atoi(tok.name)
+		return nil
 
-		// version minor
-		if matches := minorRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
-			pdl.Version.Minor, _ = strconv.Atoi(matches[0][1])
-			continue
+	case tokenMinor:
+		if s.pdl.Version == nil {
+			return p.errorf(pos, end, "version", "minor outside of version")
 		}
+		s.pdl.Version.Minor, _ = strconv.Atoi(tok.name)
+		return nil
 
-		// redirect
-		if matches := redirectRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
-			item.Redirect = &Redirect{
-				Domain: DomainType(matches[0][1]),
-			}
-			if m := redirectCommentRE.FindAllStringSubmatch(desc, -1); len(m) != 0 {
-				name := m[0][1]
-				if n := strings.LastIndex(name, "."); n != -1 {
-					name = name[n+1:]
-				}
-				item.Redirect.Name = name
-			}
-			continue
+	case tokenRedirect:
+		if s.item == nil {
+			return p.errorf(pos, end, "type, command, or event", "redirect outside of type, command, or event")
+		}
+		s.item.Redirect = newRedirect(tok.name, desc)
+		s.item.Redirect.Description = desc
+
+	case tokenEnumLiteral:
+		if s.enumliterals == nil {
+			return p.errorf(pos, end, "enum", "enum literal outside of enum")
 		}
+		*s.enumliterals = append(*s.enumliterals, trimmed)
+
+	default:
+		return p.errorf(pos, end, expectedAt(pos.Column-1), "unknown token %q", trimmed)
+	}
+
+	s.extend(pos)
+	return nil
+}
+
+// expectedTokens are the tokens expected at each indentation level.
+var expectedTokens = map[int]string{
+	0: "include, version, or domain",
+	2: "major, minor, depends on, type, command, or event",
+	4: "parameters, returns, properties, enum, or redirect",
+	6: "member or enum literal",
+	8: "enum literal",
+}
+
+// expectedAt returns the tokens expected at the indentation level, using the
+// nearest lower level for odd and excessive indentation.
+func expectedAt(indent int) string {
+	if indent > 8 {
+		indent = 8
+	}
+	return expectedTokens[indent-indent%2]
+}
+
+// span returns the source positions of the first non-space character on line
+// and immediately after the last non-space character, for the zero-based line
+// index i.
+func (p *parser) span(i int, line string) (Position, Position) {
+	pos := Position{File: p.filename, Line: i + 1}
+	end := pos
+	pos.Column = len(line) - len(strings.TrimLeft(line, " \t")) + 1
+	end.Column = len(strings.TrimRightFunc(line, unicode.IsSpace)) + 1
+	return pos, end
+}
+
+// errorf creates a parse error spanning pos to end.
+func (p *parser) errorf(pos, end Position, expected string, format string, args ...interface{}) error {
+	return &ParseError{
+		Pos:      pos,
+		End:      end,
+		Msg:      fmt.Sprintf(format, args...),
+		Expected: expected,
+	}
+}
+
+// include parses the included file name, relative to the file being parsed.
+func (p *parser) include(pos, end Position, name string) (*PDL, error) {
+	if p.fs == nil {
+		return nil, p.errorf(pos, end, "", "cannot include %q: no file system", name)
+	}
+	if path.IsAbs(name) {
+		return nil, p.errorf(pos, end, "", "cannot include %q: absolute include paths are not allowed", name)
+	}
+	joined := path.Join(path.Dir(p.filename), name)
+	if joined == ".." || strings.HasPrefix(joined, "../") {
+		return nil, p.errorf(pos, end, "", "cannot include %q: include paths outside of the file system are not allowed", name)
+	}
+	name = joined
 
-		// enum literal
-		if matches := enumLiteralRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
-			*enumliterals = append(*enumliterals, trimmed)
-			continue
+	// check for include cycles
+	parents := append(append([]string(nil), p.parents...), p.filename)
+	for _, n := range parents {
+		if n == name {
+			return nil, p.errorf(pos, end, "", "cannot include %q: include cycle", name)
 		}
+	}
 
-		return nil, fmt.Errorf("line %d unknown token %q", i, line)
+	buf, err := p.fs.ReadFile(name)
+	if err != nil {
+		return nil, p.errorf(pos, end, "", "cannot include %q: %v", name, err)
 	}
+	return (&parser{
+		fs:        p.fs,
+		filename:  name,
+		parents:   parents,
+		allErrors: p.allErrors,
+	}).parse(buf)
+}
 
-	return pdl, nil
+// newRedirect creates a redirect to domain, determining the redirected name
+// from the redirect comment in desc (if any) of the form "Use 'Domain.name'
+// instead".
+func newRedirect(domain, desc string) *Redirect {
+	r := &Redirect{
+		Domain: DomainType(domain),
+	}
+	const prefix, suffix = "Use '", "' instead"
+	if strings.HasPrefix(desc, prefix) && strings.HasSuffix(desc, suffix) && len(desc) > len(prefix)+len(suffix) {
+		name := desc[len(prefix) : len(desc)-len(suffix)]
+		if !strings.Contains(name, "'") {
+			if n := strings.LastIndex(name, "."); n != -1 {
+				name = name[n+1:]
+			}
+			r.Name = name
+		}
+	}
+	return r
 }
 
 // primitiveTypes is a map of primitive type names to their enum value.
@@ -262,54 +455,40 @@ func assignType(item *Type, typ string, isArray bool) {
 	}
 }
 
-// LoadFile loads a PDL file from the specified filename.
-func LoadFile(filename string) (*PDL, error) {
+// LoadFile loads a PDL file from the specified filename, resolving any
+// included files relative to the file's directory.
+func LoadFile(filename string, opts ...Option) (*PDL, error) {
 	buf, err := ioutil.ReadFile(filename)
 	if err != nil {
 		return nil, err
 	}
-	return Parse(buf)
+	return Parse(buf, append([]Option{
+		WithFileSystem(Dir(filepath.Dir(filename))),
+		WithFilename(filepath.Base(filename)),
+	}, opts...)...)
 }
 
-// Combine combines domains from multiple PDL definitions into a single PDL.
-func Combine(pdls ...*PDL) *PDL {
-	pdl := new(PDL)
-	for _, p := range pdls {
-		if pdl.Copyright == "" {
-			pdl.Copyright = p.Copyright
-		}
-		if pdl.Version == nil {
-			pdl.Version = new(Version)
-		}
-		if p.Version != nil {
-			if pdl.Version.Major < p.Version.Major {
-				pdl.Version.Major = p.Version.Major
-				pdl.Version.Minor = p.Version.Minor
-			} else if pdl.Version.Minor < p.Version.Minor {
-				pdl.Version.Minor = p.Version.Minor
-			}
-		}
-		pdl.Domains = append(pdl.Domains, p.Domains...)
-	}
-	return pdl
+// Bytes generates file contents for the PDL.
+//
+// The order of the domains, types, commands, and events is preserved, such
+// that parsing the generated file contents produces a PDL structurally
+// identical to pdl.
+func (pdl *PDL) Bytes() []byte {
+	return pdl.write(false)
 }
 
-// CombineBytes combines domains from multiple PDL definitions into a single
-// PDL.
-func CombineBytes(buffers ...[]byte) ([]byte, error) {
-	var pdls []*PDL
-	for _, buf := range buffers {
-		pdl, err := Parse(buf)
-		if err != nil {
-			return nil, err
-		}
-		pdls = append(pdls, pdl)
-	}
-	return Combine(pdls...).Bytes(), nil
+// CanonicalBytes generates canonically formatted file contents for the PDL,
+// with domains, types, commands and events sorted by name.
+//
+// The generated file contents are stable for a given set of definitions,
+// regardless of the order they were defined in.
+func (pdl *PDL) CanonicalBytes() []byte {
+	return pdl.write(true)
 }
 
-// Bytes generates file contents for the PDL.
-func (pdl *PDL) Bytes() []byte {
+// write generates file contents for the PDL, optionally sorting domains,
+// types, commands, and events.
+func (pdl *PDL) write(canonical bool) []byte {
 	buf := new(bytes.Buffer)
 
 	// writeDesc conditionally writes a description.
@@ -342,46 +521,40 @@ func (pdl *PDL) Bytes() []byte {
 		fmt.Fprintln(buf, indent+strings.Join(append(v, extra...), " "))
 	}
 
-	writeRedirect := func(typ *Type, indent string) {
+	// writeRedirect writes a redirect.
+	writeRedirect := func(typ *Type) {
 		if typ.Redirect == nil {
 			return
 		}
-		if typ.Redirect.Name != "" {
-			fmt.Fprintln(buf, indent+"# Use '"+typ.Redirect.Domain.String()+"."+typ.Redirect.Name+"' instead")
+		switch {
+		case typ.Redirect.Description != "":
+			writeDesc(typ.Redirect.Description, "    ")
+		case typ.Redirect.Name != "":
+			writeDesc("Use '"+typ.Redirect.String()+"' instead", "    ")
+		}
+		fmt.Fprintln(buf, "    redirect "+typ.Redirect.Domain.String())
+	}
+
+	// writeEnum writes enum values.
+	writeEnum := func(indent string, enum []string) {
+		for _, e := range enum {
+			fmt.Fprintln(buf, indent+e)
 		}
-		fmt.Fprintln(buf, indent+"redirect "+typ.Redirect.Domain.String())
 	}
 
 	// writeProps writes a list of types for object properties.
-	writeProps := func(typ string, indent string, props []*Type) {
-		if len(props) == 0 {
+	writeProps := func(typ string, props []*Type) {
+		if props == nil {
 			return
 		}
-		if indent != "" {
-			fmt.Fprint(buf, indent)
-		}
-		fmt.Fprintln(buf, typ)
+		fmt.Fprintln(buf, "    "+typ)
 		for _, p := range props {
-			ref := p.Type.String()
-			if p.Ref != "" {
-				ref = p.Ref
-			}
-			if p.Type == TypeArray {
-				ref = p.Items.Ref
-				if ref == "" {
-					ref = p.Items.Type.String()
-				}
-				ref = "array of " + ref
-			}
-			if len(p.Enum) != 0 {
+			ref := typeRef(p)
+			if p.Enum != nil {
 				ref = "enum"
 			}
-			writeDecl(ref, p.Name, p.Description, indent+"  ", p.Experimental, p.Deprecated, p.Optional)
-			if len(p.Enum) != 0 {
-				for _, e := range p.Enum {
-					fmt.Fprintln(buf, indent+"    "+e)
-				}
-			}
+			writeDecl(ref, p.Name, p.Description, "      ", p.Experimental, p.Deprecated, p.Optional)
+			writeEnum("        ", p.Enum)
 		}
 	}
 
@@ -399,15 +572,8 @@ func (pdl *PDL) Bytes() []byte {
 		fmt.Fprintln(buf)
 	}
 
-	// copy and sort domains
-	domains := make([]*Domain, len(pdl.Domains))
-	copy(domains, pdl.Domains)
-	sort.Slice(domains, func(i, j int) bool {
-		return strings.Compare(domains[i].Domain.String(), domains[j].Domain.String()) < 0
-	})
-
 	// write each domain
-	for _, d := range domains {
+	for _, d := range sortDomains(pdl.Domains, canonical) {
 		// write domain stanza
 		writeDecl("domain", d.Domain.String(), d.Description, "", d.Experimental, d.Deprecated, false)
 
@@ -417,72 +583,90 @@ func (pdl *PDL) Bytes() []byte {
 		}
 		fmt.Fprintln(buf)
 
-		// sort types
-		types := make([]*Type, len(d.Types))
-		copy(types, d.Types)
-		sort.Slice(types, func(i, j int) bool {
-			return strings.Compare(types[i].Name, types[i].Name) < 0
-		})
-
 		// write types
-		for _, typ := range types {
-			extends := typ.Type.String()
-			if typ.Type == TypeArray {
-				if typ.Items != nil {
-					extends = typ.Items.Type.String()
-					if extends == "" {
-						extends = typ.Items.Ref
-					}
-				}
-				extends = "array of " + extends
-			}
-			writeDecl("type", typ.Name, typ.Description, "  ", typ.Experimental, typ.Deprecated, typ.Optional, "extends", extends)
-			writeRedirect(typ, "  ")
-			if len(typ.Enum) != 0 {
+		for _, typ := range sortTypes(d.Types, canonical) {
+			writeDecl("type", typ.Name, typ.Description, "  ", typ.Experimental, typ.Deprecated, false, "extends", typeRef(typ))
+			writeRedirect(typ)
+			if typ.Enum != nil {
 				fmt.Fprintln(buf, "    enum")
-				for _, e := range typ.Enum {
-					fmt.Fprintln(buf, "     ", e)
-				}
+				writeEnum("      ", typ.Enum)
 			}
-			writeProps("properties", "    ", typ.Properties)
+			writeProps("properties", typ.Properties)
 			fmt.Fprintln(buf)
 		}
 
-		// sort commands
-		commands := make([]*Type, len(d.Commands))
-		copy(commands, d.Commands)
-		sort.Slice(commands, func(i, j int) bool {
-			return strings.Compare(commands[i].Name, commands[i].Name) < 0
-		})
-
-		// write commands
-		for _, c := range commands {
-			writeDecl("command", c.Name, c.Description, "  ", c.Experimental, c.Deprecated, c.Optional)
-			writeRedirect(c, "    ")
-			writeProps("parameters", "    ", c.Parameters)
-			writeProps("returns", "    ", c.Returns)
-			fmt.Fprintln(buf)
+		// writeCommands writes commands
+		writeCommands := func() {
+			for _, c := range sortTypes(d.Commands, canonical) {
+				writeDecl("command", c.Name, c.Description, "  ", c.Experimental, c.Deprecated, false)
+				writeRedirect(c)
+				writeProps("parameters", c.Parameters)
+				writeProps("returns", c.Returns)
+				fmt.Fprintln(buf)
+			}
 		}
 
-		// sort events
-		events := make([]*Type, len(d.Events))
-		copy(events, d.Events)
-		sort.Slice(events, func(i, j int) bool {
-			return strings.Compare(events[i].Name, events[i].Name) < 0
-		})
+		// writeEvents writes events
+		writeEvents := func() {
+			for _, e := range sortTypes(d.Events, canonical) {
+				writeDecl("event", e.Name, e.Description, "  ", e.Experimental, e.Deprecated, false)
+				writeRedirect(e)
+				writeProps("parameters", e.Parameters)
+				fmt.Fprintln(buf)
+			}
+		}
 
-		// write events
-		for _, e := range events {
-			writeDecl("event", e.Name, e.Description, "  ", e.Experimental, e.Deprecated, e.Optional)
-			writeRedirect(e, "    ")
-			writeProps("parameters", "    ", e.Parameters)
-			fmt.Fprintln(buf)
+		// write commands and events, preserving the original order unless
+		// canonical
+		if d.eventsFirst && !canonical {
+			writeEvents()
+			writeCommands()
+		} else {
+			writeCommands()
+			writeEvents()
 		}
 	}
 
 	return append(bytes.TrimRightFunc(buf.Bytes(), unicode.IsSpace), '\n')
 }
 
+// typeRef returns the PDL type reference for the type (ie, the type's
+// primitive type, ref, or "array of" the type's items).
+func typeRef(typ *Type) string {
+	if typ.Type == TypeArray && typ.Items != nil {
+		return "array of " + typeRef(typ.Items)
+	}
+	if typ.Ref != "" {
+		return typ.Ref
+	}
+	return typ.Type.String()
+}
+
+// sortDomains returns a copy of the domains, sorted by name when sorted is
+// true.
+func sortDomains(domains []*Domain, sorted bool) []*Domain {
+	v := make([]*Domain, len(domains))
+	copy(v, domains)
+	if sorted {
+		sort.SliceStable(v, func(i, j int) bool {
+			return v[i].Domain < v[j].Domain
+		})
+	}
+	return v
+}
+
+// sortTypes returns a copy of the types, sorted by name when sorted is true.
+func sortTypes(typs []*Type, sorted bool) []*Type {
+	v := make([]*Type, len(typs))
+	copy(v, typs)
+	if sorted {
+		sort.SliceStable(v, func(i, j int) bool {
+			return v[i].Name < v[j].Name
+		})
+	}
+	return v
+}
+
 // Version holds information for the the version Chrome DevTools Protocol
 // definition.
 type Version struct {
@@ -518,6 +702,43 @@ type Domain struct {
 
 	// Events is the list of events types in the domain.
 	Events []*Type
+
+	// Pos is the source position of the start of the domain definition.
+	Pos Position `json:"-"`
+
+	// End is the source position of the last line of the domain definition.
+	End Position `json:"-"`
+
+	// eventsFirst indicates whether events were defined before commands in
+	// the original definition.
+	eventsFirst bool
+}
+
+// Position is a source position in a PDL file.
+type Position struct {
+	// File is the file name, if known.
+	File string
+
+	// Line is the 1-based line number, or 0 when the position is not known.
+	Line int
+
+	// Column is the 1-based column number, or 0 when not known.
+	Column int
+}
+
+// String satisfies the fmt.Stringer interface.
+func (p Position) String() string {
+	if p.Line == 0 {
+		return p.File
+	}
+	s := strconv.Itoa(p.Line)
+	if p.Column != 0 {
+		s += ":" + strconv.Itoa(p.Column)
+	}
+	if p.File == "" {
+		return "line " + s
+	}
+	return p.File + ":" + s
 }
 
 // DomainType is the Chrome domain type.
@@ -605,6 +826,16 @@ type Type struct {
 
 	// Extra will be added as output after the the type is emitted.
 	Extra string `json:"-"`
+
+	// Supported is the range of Chrome versions supporting the command or
+	// event (ie, Chrome 95–current), added to its generated docs when set.
+	Supported string `json:"-"`
+
+	// Pos is the source position of the start of the type definition.
+	Pos Position `json:"-"`
+
+	// End is the source position of the last line of the type definition.
+	End Position `json:"-"`
 }
 
 // TypeEnum is the Chrome domain type enum.
@@ -645,6 +876,9 @@ type Redirect struct {
 
 	// Name is the name of the command, event, or type to redirect to.
 	Name string
+
+	// Description is the redirect's comment.
+	Description string
 }
 
 // String satisfies the fmt.Stringer interface.
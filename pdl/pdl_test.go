@@ -0,0 +1,214 @@
+package pdl
+
+import (
+	"bytes"
+	"compress/gzip"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// mapFS is a FileSystem of in-memory files.
+type mapFS map[string]string
+
+// ReadFile satisfies the FileSystem interface.
+func (fs mapFS) ReadFile(name string) ([]byte, error) {
+	buf, ok := fs[name]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return []byte(buf), nil
+}
+
+func TestInclude(t *testing.T) {
+	fs := mapFS{
+		"a/main.pdl":      "include domains/b.pdl\ninclude ../c.pdl\n",
+		"a/domains/b.pdl": "domain B\n",
+		"c.pdl":           "domain C\n",
+		"a/cycle.pdl":     "include cycle2.pdl\n",
+		"a/cycle2.pdl":    "include ./cycle.pdl\n",
+	}
+	tests := []struct {
+		filename string
+		buf      string
+		domains  []string
+		err      string
+	}{
+		{"a/main.pdl", fs["a/main.pdl"], []string{"B", "C"}, ""},
+		{"a/x.pdl", "include missing.pdl\n", nil, `cannot include "a/missing.pdl"`},
+		{"a/x.pdl", "include /etc/x.pdl\n", nil, "absolute include paths are not allowed"},
+		{"a/x.pdl", "include ../../x.pdl\n", nil, "include paths outside of the file system are not allowed"},
+		{"a/x.pdl", "include ../a/../../x.pdl\n", nil, "include paths outside of the file system are not allowed"},
+		{"x.pdl", "include ..\n", nil, "include paths outside of the file system are not allowed"},
+		{"a/cycle.pdl", fs["a/cycle.pdl"], nil, "include cycle"},
+	}
+	for i, test := range tests {
+		p, err := Parse([]byte(test.buf), WithFileSystem(fs), WithFilename(test.filename))
+		switch {
+		case test.err != "" && err == nil:
+			t.Errorf("test %d expected error, got none", i)
+			continue
+		case test.err != "" && !strings.Contains(err.Error(), test.err):
+			t.Errorf("test %d expected error containing %q, got: %v", i, test.err, err)
+			continue
+		case test.err != "":
+			continue
+		case err != nil:
+			t.Errorf("test %d expected no error, got: %v", i, err)
+			continue
+		}
+		var domains []string
+		for _, d := range p.Domains {
+			domains = append(domains, d.Domain.String())
+		}
+		if strings.Join(domains, ",") != strings.Join(test.domains, ",") {
+			t.Errorf("test %d expected domains %v, got: %v", i, test.domains, domains)
+		}
+	}
+
+	// no file system
+	if _, err := Parse([]byte("include b.pdl\n")); err == nil || !strings.Contains(err.Error(), "no file system") {
+		t.Errorf("expected no file system error, got: %v", err)
+	}
+}
+
+// update toggles updating the golden files in the testdata directory.
+var update = flag.Bool("update", false, "update golden files")
+
+// roundTripTests are the testdata files for the serialization tests, and
+// whether their contents are written exactly by Bytes (ie, have no includes
+// and are formatted the same as Bytes).
+var roundTripTests = []struct {
+	name  string
+	exact bool
+}{
+	{"kitchen-sink.pdl", true},
+	{"include.pdl", false},
+	{"devtools-protocol.pdl", false},
+}
+
+func TestBytesRoundTrip(t *testing.T) {
+	for _, test := range roundTripTests {
+		t.Run(test.name, func(t *testing.T) {
+			p := loadTestdata(t, test.name)
+			buf := p.Bytes()
+			if test.exact {
+				exp, err := ioutil.ReadFile(filepath.Join("testdata", test.name))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(buf, exp) {
+					t.Errorf("Bytes does not match the original file:\n%s", firstDiff(buf, exp))
+				}
+			}
+			q, err := Parse(buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			clearPositions(p)
+			clearPositions(q)
+			if !reflect.DeepEqual(p, q) {
+				t.Errorf("Parse(p.Bytes()) is not structurally equal to p:\n%s", firstDiff(q.Bytes(), buf))
+			}
+		})
+	}
+}
+
+func TestCanonicalBytes(t *testing.T) {
+	for _, test := range roundTripTests {
+		t.Run(test.name, func(t *testing.T) {
+			p := loadTestdata(t, test.name)
+			buf := p.CanonicalBytes()
+
+			// golden
+			golden := filepath.Join("testdata", "golden", strings.TrimSuffix(test.name, ".pdl")+".canonical.pdl")
+			if *update {
+				if err := ioutil.WriteFile(golden, buf, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			exp, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(buf, exp) {
+				t.Errorf("CanonicalBytes does not match %s:\n%s", golden, firstDiff(buf, exp))
+			}
+
+			// idempotent
+			q, err := Parse(buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if z := q.CanonicalBytes(); !bytes.Equal(z, buf) {
+				t.Errorf("CanonicalBytes is not idempotent:\n%s", firstDiff(z, buf))
+			}
+
+			// independent of definition order
+			for _, d := range p.Domains {
+				reverseTypes(d.Types)
+				reverseTypes(d.Commands)
+				reverseTypes(d.Events)
+			}
+			for i, j := 0, len(p.Domains)-1; i < j; i, j = i+1, j-1 {
+				p.Domains[i], p.Domains[j] = p.Domains[j], p.Domains[i]
+			}
+			if z := p.CanonicalBytes(); !bytes.Equal(z, buf) {
+				t.Errorf("CanonicalBytes depends on definition order:\n%s", firstDiff(z, buf))
+			}
+		})
+	}
+}
+
+// reverseTypes reverses typs in place.
+func reverseTypes(typs []*Type) {
+	for i, j := 0, len(typs)-1; i < j; i, j = i+1, j-1 {
+		typs[i], typs[j] = typs[j], typs[i]
+	}
+}
+
+// readCombined reads the combined browser, js, and HAR protocol definitions
+// in testdata/combined.pdl.gz.
+func readCombined(t testing.TB) []byte {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", "combined.pdl.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf
+}
+
+func BenchmarkParse(b *testing.B) {
+	buf := readCombined(b)
+	b.Run("lexer", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(buf)))
+		for i := 0; i < b.N; i++ {
+			if _, err := Parse(buf); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("regexp", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(buf)))
+		for i := 0; i < b.N; i++ {
+			if _, err := parseRegexp(buf, "", false); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
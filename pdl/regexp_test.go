@@ -0,0 +1,317 @@
+package pdl
+
+// This file contains a frozen copy of the regexp based PDL parser replaced
+// by the lexer, used to check that the lexer accepts exactly the same
+// grammar. It must not be changed, other than to follow changes to the
+// fields of Type.
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// regexpParser is the regexp based PDL parser. Includes are not supported.
+type regexpParser struct {
+	filename  string
+	allErrors bool
+}
+
+// parseRegexp parses the PDL contained in buf with the regexp based parser.
+func parseRegexp(buf []byte, filename string, allErrors bool) (*PDL, error) {
+	return (&regexpParser{filename: filename, allErrors: allErrors}).parse(buf)
+}
+
+// parse parses the PDL contained in buf.
+func (p *regexpParser) parse(buf []byte) (*PDL, error) {
+	s := &state{
+		pdl: new(PDL),
+	}
+	var errs ErrorList
+	for i, line := range strings.Split(string(buf), "\n") {
+		if err := p.line(s, i, line); err != nil {
+			if !p.allErrors {
+				return nil, err
+			}
+			errs = errs.add(err)
+		}
+	}
+	if len(errs) != 0 {
+		return nil, errs
+	}
+	return s.pdl, nil
+}
+
+// regexp's copied from pdl.py in the chromium source tree.
+var (
+	includeRE         = regexp.MustCompile(`^include (.*)`)
+	domainRE          = regexp.MustCompile(`^(experimental )?(deprecated )?domain (.*)`)
+	dependsRE         = regexp.MustCompile(`^  depends on ([^\s]+)`)
+	typeRE            = regexp.MustCompile(`^  (experimental )?(deprecated )?type (.*) extends (array of )?([^\s]+)`)
+	commandEventRE    = regexp.MustCompile(`^  (experimental )?(deprecated )?(command|event) (.*)`)
+	memberRE          = regexp.MustCompile(`^      (experimental )?(deprecated )?(optional )?(array of )?([^\s]+) ([^\s]+)`)
+	paramsRetsPropsRE = regexp.MustCompile(`^    (parameters|returns|properties)`)
+	enumRE            = regexp.MustCompile(`^    enum`)
+	versionRE         = regexp.MustCompile(`^version`)
+	majorRE           = regexp.MustCompile(`^  major (\d+)`)
+	minorRE           = regexp.MustCompile(`^  minor (\d+)`)
+	redirectRE        = regexp.MustCompile(`^    redirect ([^\s]+)`)
+	enumLiteralRE     = regexp.MustCompile(`^      (  )?[^\s]+$`)
+)
+
+// line parses the line with the zero-based line index i.
+func (p *regexpParser) line(s *state, i int, line string) error {
+	// clear the description if toggled
+	if s.clearDesc {
+		s.desc, s.clearDesc = "", false
+	}
+
+	// trim the line
+	trimmed := strings.TrimSpace(line)
+
+	// add to desc
+	if strings.HasPrefix(trimmed, "#") {
+		if len(s.desc) != 0 {
+			s.desc += "\n"
+		}
+		s.desc += strings.TrimPrefix(trimmed[1:], " ")
+		return nil
+	}
+
+	// the copyright is the leading comment, when followed by an empty line
+	if !s.copyright {
+		s.copyright = true
+		if len(trimmed) == 0 {
+			s.pdl.Copyright = s.desc
+		}
+	}
+	s.clearDesc = true
+
+	// skip empty line
+	if len(trimmed) == 0 {
+		return nil
+	}
+
+	pos := p.pos(i, line)
+	desc := strings.TrimSpace(s.desc)
+
+	// include
+	if matches := includeRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
+		return p.errorf(pos, "", "cannot include %q: not supported", matches[0][1])
+	}
+
+	// domain
+	if matches := domainRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
+		s.domain = &Domain{
+			Pos:          pos,
+			Domain:       DomainType(matches[0][3]),
+			Experimental: matches[0][1] != "",
+			Deprecated:   matches[0][2] != "",
+			Description:  desc,
+		}
+		s.item, s.member, s.subitems, s.enumliterals = nil, nil, nil, nil
+		s.pdl.Domains = append(s.pdl.Domains, s.domain)
+		s.extend(pos)
+		return nil
+	}
+
+	// dependencies
+	if matches := dependsRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
+		if s.domain == nil {
+			return p.errorf(pos, "domain", "depends on outside of domain")
+		}
+		s.domain.Dependencies = append(s.domain.Dependencies, matches[0][1])
+		s.extend(pos)
+		return nil
+	}
+
+	// type
+	if matches := typeRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
+		if s.domain == nil {
+			return p.errorf(pos, "domain", "type outside of domain")
+		}
+		s.item = &Type{
+			Pos:          pos,
+			RawType:      "type",
+			RawName:      s.domain.Domain.String() + "." + matches[0][3],
+			Name:         matches[0][3],
+			Experimental: matches[0][1] != "",
+			Deprecated:   matches[0][2] != "",
+			Description:  desc,
+		}
+		assignType(s.item, matches[0][5], matches[0][4] != "")
+		s.member, s.subitems, s.enumliterals = nil, nil, nil
+		s.domain.Types = append(s.domain.Types, s.item)
+		s.extend(pos)
+		return nil
+	}
+
+	// command or event
+	if matches := commandEventRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
+		if s.domain == nil {
+			return p.errorf(pos, "domain", "%s outside of domain", matches[0][3])
+		}
+		s.item = &Type{
+			Pos:          pos,
+			RawName:      s.domain.Domain.String() + "." + matches[0][4],
+			Name:         matches[0][4],
+			Experimental: matches[0][1] != "",
+			Deprecated:   matches[0][2] != "",
+			Description:  desc,
+		}
+		if matches[0][3] == "command" {
+			s.item.RawType = "command"
+			s.domain.Commands = append(s.domain.Commands, s.item)
+		} else {
+			s.item.RawType = "event"
+			s.domain.Events = append(s.domain.Events, s.item)
+			s.domain.eventsFirst = s.domain.eventsFirst || len(s.domain.Commands) == 0
+		}
+		s.member, s.subitems, s.enumliterals = nil, nil, nil
+		s.extend(pos)
+		return nil
+	}
+
+	// member to params / returns / properties
+	if matches := memberRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
+		if s.subitems == nil {
+			return p.errorf(pos, "parameters, returns, or properties", "member outside of parameters, returns, or properties")
+		}
+		s.member = &Type{
+			Pos:          pos,
+			RawName:      s.domain.Domain.String() + "." + matches[0][6],
+			Name:         matches[0][6],
+			Experimental: matches[0][1] != "",
+			Deprecated:   matches[0][2] != "",
+			Description:  desc,
+			Optional:     matches[0][3] != "",
+		}
+		assignType(s.member, matches[0][5], matches[0][4] != "")
+		s.enumliterals = nil
+		if matches[0][5] == "enum" {
+			s.member.Enum = make([]string, 0)
+			s.enumliterals = &s.member.Enum
+		}
+		*s.subitems = append(*s.subitems, s.member)
+		s.extend(pos)
+		return nil
+	}
+
+	// parameters, returns, properties definition
+	if matches := paramsRetsPropsRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
+		if s.item == nil {
+			return p.errorf(pos, "type, command, or event", "%s outside of type, command, or event", matches[0][1])
+		}
+		switch matches[0][1] {
+		case "parameters":
+			s.item.Parameters = make([]*Type, 0)
+			s.subitems = &s.item.Parameters
+		case "returns":
+			s.item.Returns = make([]*Type, 0)
+			s.subitems = &s.item.Returns
+		case "properties":
+			s.item.Properties = make([]*Type, 0)
+			s.subitems = &s.item.Properties
+		}
+		s.member, s.enumliterals = nil, nil
+		s.extend(pos)
+		return nil
+	}
+
+	// enum
+	if matches := enumRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
+		if s.item == nil {
+			return p.errorf(pos, "type", "enum outside of type")
+		}
+		s.item.Enum = make([]string, 0)
+		s.member, s.subitems, s.enumliterals = nil, nil, &s.item.Enum
+		s.extend(pos)
+		return nil
+	}
+
+	// version
+	if matches := versionRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
+		s.pdl.Version = new(Version)
+		return nil
+	}
+
+	// version major
+	if matches := majorRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
+		if s.pdl.Version == nil {
+			return p.errorf(pos, "version", "major outside of version")
+		}
+		s.pdl.Version.Major, _ = strconv.Atoi(matches[0][1])
+		return nil
+	}
+
+	// version minor
+	if matches := minorRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
+		if s.pdl.Version == nil {
+			return p.errorf(pos, "version", "minor outside of version")
+		}
+		s.pdl.Version.Minor, _ = strconv.Atoi(matches[0][1])
+		return nil
+	}
+
+	// redirect
+	if matches := redirectRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
+		if s.item == nil {
+			return p.errorf(pos, "type, command, or event", "redirect outside of type, command, or event")
+		}
+		s.item.Redirect = newRedirectRegexp(matches[0][1], desc)
+		s.item.Redirect.Description = desc
+		s.extend(pos)
+		return nil
+	}
+
+	// enum literal
+	if matches := enumLiteralRE.FindAllStringSubmatch(line, -1); len(matches) != 0 {
+		if s.enumliterals == nil {
+			return p.errorf(pos, "enum", "enum literal outside of enum")
+		}
+		*s.enumliterals = append(*s.enumliterals, trimmed)
+		s.extend(pos)
+		return nil
+	}
+
+	return p.errorf(pos, expectedTokens[pos.Column-1], "unknown token %q", trimmed)
+}
+
+// pos returns the source position of the first non-space character on line,
+// for the zero-based line index i.
+func (p *regexpParser) pos(i int, line string) Position {
+	return Position{
+		File:   p.filename,
+		Line:   i + 1,
+		Column: len(line) - len(strings.TrimLeft(line, " \t")) + 1,
+	}
+}
+
+// errorf creates a parse error at pos.
+func (p *regexpParser) errorf(pos Position, expected string, format string, args ...interface{}) error {
+	return &ParseError{
+		Pos:      pos,
+		Msg:      fmt.Sprintf(format, args...),
+		Expected: expected,
+	}
+}
+
+// redirectCommentRE matches the comment for a redirect.
+var redirectCommentRE = regexp.MustCompile(`^Use '([^']+)' instead$`)
+
+// newRedirectRegexp creates a redirect to domain, determining the redirected
+// name from the redirect comment in desc (if any).
+func newRedirectRegexp(domain, desc string) *Redirect {
+	r := &Redirect{
+		Domain: DomainType(domain),
+	}
+	if m := redirectCommentRE.FindAllStringSubmatch(desc, -1); len(m) != 0 {
+		name := m[0][1]
+		if n := strings.LastIndex(name, "."); n != -1 {
+			name = name[n+1:]
+		}
+		r.Name = name
+	}
+	return r
+}
@@ -0,0 +1,44 @@
+package pdl
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+)
+
+func TestSnapshot(t *testing.T) {
+	if Snapshot == "" || SnapshotChromium == "" || SnapshotV8 == "" {
+		t.Fatal("snapshot is empty: run go generate in the pdl directory")
+	}
+	verRE := regexp.MustCompile(`^[0-9]+(\.[0-9]+){3}$`)
+	for _, ver := range []string{SnapshotChromium, SnapshotV8} {
+		if !verRE.MatchString(ver) {
+			t.Errorf("invalid snapshot version %q", ver)
+		}
+	}
+	p, err := Parse([]byte(Snapshot))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, problem := range Validate(p) {
+		if !problem.Warning {
+			t.Errorf("snapshot problem: %v", problem)
+		}
+	}
+
+	// browser, js, and har domains
+	domains := make(map[DomainType]bool)
+	for _, d := range p.Domains {
+		domains[d.Domain] = true
+	}
+	for _, d := range []DomainType{"Page", "Network", "Runtime", "Debugger", "HAR"} {
+		if !domains[d] {
+			t.Errorf("expected snapshot to contain domain %s", d)
+		}
+	}
+
+	// canonical
+	if buf := p.CanonicalBytes(); !bytes.Equal(buf, []byte(Snapshot)) {
+		t.Errorf("snapshot is not canonical:\n%s", firstDiff(buf, []byte(Snapshot)))
+	}
+}
@@ -0,0 +1,212 @@
+package pdl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Problem is a problem found in a protocol definition.
+type Problem struct {
+	// Path is the path of the domain, type, command, event, or member (ie,
+	// Domain.command.param).
+	Path string
+
+	// Pos is the source position of the definition.
+	Pos Position
+
+	// Msg is the description of the problem.
+	Msg string
+
+	// Warning indicates the problem does not prevent generating code from
+	// the definition.
+	Warning bool
+}
+
+// Error satisfies the error interface.
+func (p Problem) Error() string {
+	if s := p.Pos.String(); s != "" {
+		return s + ": " + p.Path + ": " + p.Msg
+	}
+	return p.Path + ": " + p.Msg
+}
+
+// ProblemList is a list of problems.
+type ProblemList []Problem
+
+// Error satisfies the error interface.
+func (l ProblemList) Error() string {
+	s := make([]string, len(l))
+	for i, p := range l {
+		s[i] = p.Error()
+	}
+	return strings.Join(s, "\n")
+}
+
+// Validate checks the protocol definition for semantic problems, returning
+// any found in definition order.
+//
+// Checks for unresolved type references, duplicate domain, type, command,
+// event, and member names, and enums on non-string types. Redirects to
+// missing domains or items, unknown domain dependencies, and empty objects are
+// reported as warnings.
+func Validate(pdl *PDL) []Problem {
+	v := &validator{
+		domains: make(map[DomainType]*Domain),
+	}
+	for _, d := range pdl.Domains {
+		if _, ok := v.domains[d.Domain]; ok {
+			v.errorf(d.Domain.String(), d.Pos, "duplicate domain")
+			continue
+		}
+		v.domains[d.Domain] = d
+	}
+	for _, d := range pdl.Domains {
+		v.domain(d)
+	}
+	return v.problems
+}
+
+// validator holds the state for validating a protocol definition.
+type validator struct {
+	domains  map[DomainType]*Domain
+	problems []Problem
+}
+
+// errorf adds a problem.
+func (v *validator) errorf(path string, pos Position, format string, args ...interface{}) {
+	v.problems = append(v.problems, Problem{
+		Path: path,
+		Pos:  pos,
+		Msg:  fmt.Sprintf(format, args...),
+	})
+}
+
+// warnf adds a warning problem.
+func (v *validator) warnf(path string, pos Position, format string, args ...interface{}) {
+	v.problems = append(v.problems, Problem{
+		Path:    path,
+		Pos:     pos,
+		Msg:     fmt.Sprintf(format, args...),
+		Warning: true,
+	})
+}
+
+// domain validates a domain.
+func (v *validator) domain(d *Domain) {
+	name := d.Domain.String()
+
+	// check dependencies
+	for _, dep := range d.Dependencies {
+		if _, ok := v.domains[DomainType(dep)]; !ok {
+			v.warnf(name, d.Pos, "depends on unknown domain %s", dep)
+		}
+	}
+
+	v.items(d, "type", d.Types)
+	v.items(d, "command", d.Commands)
+	v.items(d, "event", d.Events)
+}
+
+// items validates a domain's types, commands, or events.
+func (v *validator) items(d *Domain, kind string, typs []*Type) {
+	seen := make(map[string]bool)
+	for _, t := range typs {
+		path := d.Domain.String() + "." + t.Name
+		if seen[t.Name] {
+			v.errorf(path, t.Pos, "duplicate %s", kind)
+		}
+		seen[t.Name] = true
+
+		if t.Redirect != nil {
+			v.redirect(path, kind, t)
+		}
+
+		if kind == "type" {
+			v.typ(d, path, t)
+			if t.Type == TypeObject && t.Properties != nil && len(t.Properties) == 0 {
+				v.warnf(path, t.Pos, "empty object")
+			}
+		}
+
+		v.members(d, path, "property", t.Properties)
+		v.members(d, path, "parameter", t.Parameters)
+		v.members(d, path, "return value", t.Returns)
+	}
+}
+
+// members validates the properties, parameters, or return values of a type.
+func (v *validator) members(d *Domain, path, kind string, members []*Type) {
+	if members == nil {
+		return
+	}
+	seen := make(map[string]bool)
+	for _, m := range members {
+		p := path + "." + m.Name
+		if seen[m.Name] {
+			v.errorf(p, m.Pos, "duplicate %s", kind)
+		}
+		seen[m.Name] = true
+		v.typ(d, p, m)
+	}
+}
+
+// typ validates a type's references and enum values.
+func (v *validator) typ(d *Domain, path string, t *Type) {
+	if t.Ref != "" && !v.resolves(d, t.Ref) {
+		v.errorf(path, t.Pos, "unresolved type %s", t.Ref)
+	}
+	if t.Items != nil && t.Items.Ref != "" && !v.resolves(d, t.Items.Ref) {
+		v.errorf(path, t.Pos, "unresolved array item type %s", t.Items.Ref)
+	}
+	if t.Enum != nil && t.Type != TypeString {
+		typ := t.Type.String()
+		if t.Ref != "" {
+			typ = t.Ref
+		}
+		v.errorf(path, t.Pos, "enum on non-string type %s", typ)
+	}
+}
+
+// resolves determines if ref resolves to a type, relative to domain d when
+// ref is not namespaced.
+func (v *validator) resolves(d *Domain, ref string) bool {
+	dtyp, name := d.Domain, ref
+	if i := strings.Index(ref, "."); i != -1 {
+		dtyp, name = DomainType(ref[:i]), ref[i+1:]
+	}
+	z, ok := v.domains[dtyp]
+	return ok && findType(z.Types, name) != nil
+}
+
+// redirect validates a type, command, or event redirect.
+func (v *validator) redirect(path, kind string, t *Type) {
+	z, ok := v.domains[t.Redirect.Domain]
+	switch {
+	case !ok:
+		v.warnf(path, t.Pos, "redirect to unknown domain %s", t.Redirect.Domain)
+		return
+	case t.Redirect.Name == "":
+		return
+	}
+
+	typs := z.Types
+	switch kind {
+	case "command":
+		typs = z.Commands
+	case "event":
+		typs = z.Events
+	}
+	if findType(typs, t.Redirect.Name) == nil {
+		v.warnf(path, t.Pos, "redirect to unknown %s %s", kind, t.Redirect)
+	}
+}
+
+// findType returns the type with name, or nil if not found.
+func findType(typs []*Type, name string) *Type {
+	for _, t := range typs {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
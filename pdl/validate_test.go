@@ -0,0 +1,180 @@
+package pdl
+
+import (
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		buf  string
+		exp  []Problem
+	}{
+		{"valid", `
+domain A
+  depends on B
+
+  type Id extends string
+    enum
+      x
+  type Obj extends object
+    properties
+      Id id
+      array of B.Id ids
+      optional enum e
+        y
+  command c
+    # Use 'B.c' instead
+    redirect B
+  event e
+    parameters
+      Obj obj
+
+domain B
+
+  type Id extends integer
+  command c
+`, nil},
+		{"duplicate domain", `
+domain A
+domain A
+`, []Problem{
+			{Path: "A", Pos: Position{Line: 3, Column: 1}, Msg: "duplicate domain"},
+		}},
+		{"unknown dependency", `
+domain A
+  depends on B
+`, []Problem{
+			{Path: "A", Pos: Position{Line: 2, Column: 1}, Msg: "depends on unknown domain B", Warning: true},
+		}},
+		{"duplicate type", `
+domain A
+  type T extends string
+  type T extends integer
+`, []Problem{
+			{Path: "A.T", Pos: Position{Line: 4, Column: 3}, Msg: "duplicate type"},
+		}},
+		{"duplicate command", `
+domain A
+  command c
+  command c
+`, []Problem{
+			{Path: "A.c", Pos: Position{Line: 4, Column: 3}, Msg: "duplicate command"},
+		}},
+		{"duplicate event", `
+domain A
+  event e
+  event e
+`, []Problem{
+			{Path: "A.e", Pos: Position{Line: 4, Column: 3}, Msg: "duplicate event"},
+		}},
+		{"duplicate property", `
+domain A
+  type T extends object
+    properties
+      string p
+      integer p
+`, []Problem{
+			{Path: "A.T.p", Pos: Position{Line: 6, Column: 7}, Msg: "duplicate property"},
+		}},
+		{"duplicate parameter", `
+domain A
+  command c
+    parameters
+      string p
+      string p
+`, []Problem{
+			{Path: "A.c.p", Pos: Position{Line: 6, Column: 7}, Msg: "duplicate parameter"},
+		}},
+		{"duplicate return value", `
+domain A
+  command c
+    returns
+      string r
+      string r
+`, []Problem{
+			{Path: "A.c.r", Pos: Position{Line: 6, Column: 7}, Msg: "duplicate return value"},
+		}},
+		{"unresolved type", `
+domain A
+  type T extends B.Missing
+  command c
+    parameters
+      Missing m
+`, []Problem{
+			{Path: "A.T", Pos: Position{Line: 3, Column: 3}, Msg: "unresolved type B.Missing"},
+			{Path: "A.c.m", Pos: Position{Line: 6, Column: 7}, Msg: "unresolved type Missing"},
+		}},
+		{"unresolved array item type", `
+domain A
+  type T extends array of Missing
+`, []Problem{
+			{Path: "A.T", Pos: Position{Line: 3, Column: 3}, Msg: "unresolved array item type Missing"},
+		}},
+		{"enum on non-string type", `
+domain A
+  type T extends integer
+    enum
+      one
+`, []Problem{
+			{Path: "A.T", Pos: Position{Line: 3, Column: 3}, Msg: "enum on non-string type integer"},
+		}},
+		{"empty object", `
+domain A
+  type T extends object
+    properties
+  type U extends object
+`, []Problem{
+			{Path: "A.T", Pos: Position{Line: 3, Column: 3}, Msg: "empty object", Warning: true},
+		}},
+		{"redirect to unknown domain", `
+domain A
+  command c
+    redirect B
+`, []Problem{
+			{Path: "A.c", Pos: Position{Line: 3, Column: 3}, Msg: "redirect to unknown domain B", Warning: true},
+		}},
+		{"redirect to unknown item", `
+domain A
+  type T extends string
+    # Use 'B.T' instead
+    redirect B
+  command c
+    # Use 'B.missing' instead
+    redirect B
+  event e
+    # Use 'B.c' instead
+    redirect B
+
+domain B
+  command c
+`, []Problem{
+			{Path: "A.T", Pos: Position{Line: 3, Column: 3}, Msg: "redirect to unknown type B.T", Warning: true},
+			{Path: "A.c", Pos: Position{Line: 6, Column: 3}, Msg: "redirect to unknown command B.missing", Warning: true},
+			{Path: "A.e", Pos: Position{Line: 9, Column: 3}, Msg: "redirect to unknown event B.c", Warning: true},
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := Parse([]byte(test.buf))
+			if err != nil {
+				t.Fatal(err)
+			}
+			problems := Validate(p)
+			if len(problems) != len(test.exp) {
+				t.Fatalf("expected %d problems, got: %v", len(test.exp), problems)
+			}
+			for i, problem := range problems {
+				if problem != test.exp[i] {
+					t.Errorf("problem %d expected %v (warning: %t), got: %v (warning: %t)", i, test.exp[i], test.exp[i].Warning, problem, problem.Warning)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateTestdata(t *testing.T) {
+	if problems := Validate(loadTestdata(t, "include.pdl")); len(problems) != 0 {
+		t.Errorf("expected no problems, got: %v", problems)
+	}
+}
@@ -0,0 +1,399 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	glob "github.com/ryanuber/go-glob"
+
+	"github.com/chromedp/cdproto-gen/gen/genutil"
+	"github.com/chromedp/cdproto-gen/gen/gotpl"
+	"github.com/chromedp/cdproto-gen/pdl"
+	"github.com/chromedp/cdproto-gen/util"
+)
+
+// queryUsage is the usage of the query command.
+const queryUsage = `usage: %s query [flags] <pattern>...
+
+Displays the domains, types, commands, events, and members (ie, properties,
+parameters, and return values) of the protocol definitions whose paths (ie,
+Network.getCookies.urls) match any of the glob patterns (ie, Network.*Cookie*
+or *.frameId), or with -regex, regular expressions, along with the Go
+identifiers, types, and packages generated for them.
+
+The protocol definitions are loaded as by the generator (see -chromium, -v8,
+-pdl, -endpoint, and -offline).
+
+Flags:
+`
+
+// runQuery runs the query command.
+func runQuery(args []string) error {
+	fs := flag.NewFlagSet("query", flag.ExitOnError)
+	regex := fs.Bool("regex", false, "toggle treating patterns as regular expressions")
+	asJSON := fs.Bool("json", false, "toggle json output")
+	inheritFlags(fs, "cache", "ttl", "timeout", "retries", "chromium", "v8", "latest", "releases", "offline",
+		"source", "chromium-src", "v8-src", "pdl", "endpoint", "merge", "overlay", "go-pkg")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), queryUsage, os.Args[0])
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	// compile patterns
+	var matchers []func(string) bool
+	for _, pattern := range fs.Args() {
+		pattern := pattern
+		if !*regex {
+			matchers = append(matchers, func(s string) bool {
+				return glob.Glob(pattern, s)
+			})
+			continue
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q: %v", pattern, err)
+		}
+		matchers = append(matchers, re.MatchString)
+	}
+	match := func(s string) bool {
+		for _, f := range matchers {
+			if f(s) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// load
+	if err := setCache(); err != nil {
+		return err
+	}
+	lk, err := newLocker()
+	if err != nil {
+		return err
+	}
+	protoDefs, _, err := loadProtocol(lk)
+	if err != nil {
+		return err
+	}
+
+	// search
+	results := queryPDL(protoDefs, match)
+	if len(results) == 0 {
+		return fmt.Errorf("no definitions matching %s", strings.Join(fs.Args(), " "))
+	}
+
+	// determine go definitions, without logging the skipped definitions
+	logf := util.Logf
+	util.Logf = func(string, ...interface{}) {}
+	processed, _, err := processDomains(protoDefs)
+	util.Logf = logf
+	if err != nil {
+		return err
+	}
+	for _, res := range results {
+		res.Go = goDef(res, processed)
+	}
+
+	if *asJSON {
+		buf, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(append(buf, '\n'))
+		return err
+	}
+	for _, res := range results {
+		res.write(os.Stdout)
+	}
+	return nil
+}
+
+// QueryResult is a protocol definitions element matching a query.
+type QueryResult struct {
+	// Element is the kind of the element (ie, domain, type, command, event,
+	// property, parameter, or return value).
+	Element string `json:"element"`
+
+	// Path is the path of the element (ie, Domain.command.param).
+	Path string `json:"path"`
+
+	// Description is the element's description.
+	Description string `json:"description,omitempty"`
+
+	// Type is the element's protocol type (ie, string, array, or a ref).
+	Type string `json:"type,omitempty"`
+
+	// Enum are the element's enum values.
+	Enum []string `json:"enum,omitempty"`
+
+	// Redirect is the element's redirect, if any.
+	Redirect string `json:"redirect,omitempty"`
+
+	Optional     bool `json:"optional,omitempty"`
+	Experimental bool `json:"experimental,omitempty"`
+	Deprecated   bool `json:"deprecated,omitempty"`
+
+	// Go is the Go definition generated for the element, or nil when none is
+	// generated (ie, for deprecated elements).
+	Go *GoDef `json:"go,omitempty"`
+
+	d      *pdl.Domain
+	t      *pdl.Type
+	parent *pdl.Type
+}
+
+// GoDef is the Go definition generated for a protocol definitions element.
+type GoDef struct {
+	// Package is the import path of the Go package.
+	Package string `json:"package"`
+
+	// Name is the Go identifier, qualified by the struct for fields (ie,
+	// GetCookiesParams.Urls).
+	Name string `json:"name"`
+
+	// Type is the Go type.
+	Type string `json:"type,omitempty"`
+
+	// Func is the signature of the func creating a command, or of the option
+	// func setting an optional command parameter.
+	Func string `json:"func,omitempty"`
+
+	// Returns is the Go type of a command's return values.
+	Returns string `json:"returns,omitempty"`
+
+	// Error is the error determining the Go definition, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// queryPDL returns the elements of the protocol definitions whose paths
+// match, in definition order.
+func queryPDL(protoDefs *pdl.PDL, match func(string) bool) []*QueryResult {
+	var results []*QueryResult
+	add := func(element, path string, d *pdl.Domain, t, parent *pdl.Type) {
+		if !match(path) {
+			return
+		}
+		res := &QueryResult{
+			Element: element,
+			Path:    path,
+			d:       d,
+			t:       t,
+			parent:  parent,
+		}
+		if t == nil {
+			res.Description, res.Experimental, res.Deprecated = d.Description, d.Experimental, d.Deprecated
+		} else {
+			res.Description, res.Experimental, res.Deprecated = t.Description, t.Experimental, t.Deprecated
+			res.Type, res.Enum, res.Optional = protoType(t), t.Enum, t.Optional
+			if t.Redirect != nil {
+				res.Redirect = t.Redirect.String()
+			}
+		}
+		results = append(results, res)
+	}
+	items := func(element string, d *pdl.Domain, types []*pdl.Type) {
+		for _, t := range types {
+			p := d.Domain.String() + "." + t.Name
+			add(element, p, d, t, nil)
+			for _, member := range []string{"property", "parameter", "return value"} {
+				for _, z := range memberTypes(t, member) {
+					add(member, p+"."+z.Name, d, z, t)
+				}
+			}
+		}
+	}
+	for _, d := range protoDefs.Domains {
+		add("domain", d.Domain.String(), d, nil, nil)
+		items("type", d, d.Types)
+		items("command", d, d.Commands)
+		items("event", d, d.Events)
+	}
+	return results
+}
+
+// protoType returns the protocol type of t.
+func protoType(t *pdl.Type) string {
+	switch {
+	case t.Ref != "":
+		return t.Ref
+	case t.Type == pdl.TypeArray && t.Items != nil:
+		return "array of " + protoType(t.Items)
+	}
+	return t.Type.String()
+}
+
+// goDef returns the Go definition generated for the element, or nil when the
+// element is not generated.
+func goDef(res *QueryResult, domains []*pdl.Domain) (def *GoDef) {
+	d, t, parent := res.d, res.t, res.parent
+
+	// skip when not generated (ie, deprecated)
+	var generated bool
+	for _, z := range domains {
+		generated = generated || z == d
+	}
+	switch {
+	case !generated:
+		return nil
+	case t == nil:
+	case parent == nil && !containsType(t, d.Types, d.Commands, d.Events):
+		return nil
+	case parent != nil && !containsType(parent, d.Types, d.Commands, d.Events):
+		return nil
+	case parent != nil:
+		// fixups replace members, so use the processed member with the name
+		var member *pdl.Type
+		for _, z := range memberTypes(parent, res.Element) {
+			if z.Name == t.Name {
+				member = z
+			}
+		}
+		if member == nil {
+			return nil
+		}
+		t = member
+	}
+
+	pkg := genutil.PackageName(d)
+	def = &GoDef{Package: path.Join(*flagGoPkg, pkg)}
+	if t == nil {
+		def.Name = pkg
+		return def
+	}
+	owner := t
+	if parent != nil {
+		owner = parent
+	}
+	if owner.IsCircularDep {
+		def.Package = path.Join(*flagGoPkg, "cdp")
+	}
+
+	// the go templates panic on unresolvable types
+	defer func() {
+		if e := recover(); e != nil {
+			def.Error = fmt.Sprint(e)
+		}
+	}()
+
+	switch res.Element {
+	case "type":
+		def.Name = gotpl.TypeName(t, gotpl.TypePrefix, gotpl.TypeSuffix)
+		def.Type = "struct"
+		if t.Type != pdl.TypeObject {
+			def.Type = gotpl.GoType(t, d, domains)
+		}
+	case "command":
+		def.Name, def.Type = gotpl.CommandType(t), "struct"
+		def.Func = fmt.Sprintf("%s(%s) *%s", gotpl.CamelName(t), strings.Replace(gotpl.ParamList(t, d, domains, false), ",", ", ", -1), def.Name)
+		if len(t.Returns) != 0 {
+			def.Returns = gotpl.CommandReturnsType(t)
+		}
+	case "event":
+		def.Name, def.Type = gotpl.EventType(t), "struct"
+	default:
+		var typ string
+		switch {
+		case res.Element == "property":
+			typ = gotpl.TypeName(parent, gotpl.TypePrefix, gotpl.TypeSuffix)
+		case res.Element == "return value":
+			typ = gotpl.CommandReturnsType(parent)
+		case containsType(parent, d.Commands):
+			typ = gotpl.CommandType(parent)
+		default:
+			typ = gotpl.EventType(parent)
+		}
+		name := gotpl.GoName(t, false)
+		def.Name, def.Type = typ+"."+name, gotpl.GoType(t, d, domains)
+		if res.Element == "parameter" && t.Optional && typ == gotpl.CommandType(parent) {
+			opt := gotpl.OptionFuncPrefix + name + gotpl.OptionFuncSuffix
+			def.Func = fmt.Sprintf("(p %s) %s(%s %s) *%s", typ, opt, gotpl.GoName(t, true), def.Type, typ)
+		}
+	}
+	return def
+}
+
+// memberTypes returns the properties, parameters, or return values of t.
+func memberTypes(t *pdl.Type, element string) []*pdl.Type {
+	switch element {
+	case "property":
+		return t.Properties
+	case "parameter":
+		return t.Parameters
+	}
+	return t.Returns
+}
+
+// containsType returns true when t is in any of the lists of types.
+func containsType(t *pdl.Type, types ...[]*pdl.Type) bool {
+	for _, v := range types {
+		for _, z := range v {
+			if z == t {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// write writes the result as text.
+func (res *QueryResult) write(w io.Writer) {
+	var flags []string
+	for _, f := range []struct {
+		name string
+		ok   bool
+	}{
+		{"optional", res.Optional},
+		{"experimental", res.Experimental},
+		{"deprecated", res.Deprecated},
+	} {
+		if f.ok {
+			flags = append(flags, f.name)
+		}
+	}
+	s := res.Element + " " + res.Path
+	if res.Type != "" {
+		s += ": " + res.Type
+	}
+	if len(flags) != 0 {
+		s += " [" + strings.Join(flags, ", ") + "]"
+	}
+	fmt.Fprintln(w, s)
+	if len(res.Enum) != 0 {
+		fmt.Fprintf(w, "    enum: %s\n", strings.Join(res.Enum, ", "))
+	}
+	if res.Redirect != "" {
+		fmt.Fprintf(w, "    redirect: %s\n", res.Redirect)
+	}
+	if desc := strings.TrimSpace(genutil.CleanDesc(res.Description)); desc != "" {
+		fmt.Fprintln(w, genutil.Wrap(desc, 80, "    "))
+	}
+	switch def := res.Go; {
+	case def == nil:
+		fmt.Fprintln(w, "    go: (not generated)")
+	case def.Error != "":
+		fmt.Fprintf(w, "    go: %s (%s)\n", def.Package, def.Error)
+	case res.t == nil:
+		fmt.Fprintf(w, "    go: package %s\n", def.Package)
+	default:
+		fmt.Fprintf(w, "    go: %s.%s %s\n", path.Base(def.Package), def.Name, def.Type)
+		if def.Func != "" {
+			fmt.Fprintf(w, "    go: func %s\n", def.Func)
+		}
+		if def.Returns != "" {
+			fmt.Fprintf(w, "    go: returns %s\n", def.Returns)
+		}
+	}
+	fmt.Fprintln(w)
+}
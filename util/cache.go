@@ -1,11 +1,21 @@
 package util
 
 import (
+	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
+	"syscall"
 	"time"
 )
 
@@ -15,11 +25,41 @@ type Cache struct {
 	Path   string
 	TTL    time.Duration
 	Decode bool
+
+	// Client is the HTTP client used to retrieve the file. When nil,
+	// DefaultClient is used.
+	Client *http.Client
+}
+
+// Offline toggles retrieving files only from disk. When enabled, Get ignores
+// the TTL of files on disk, and returns an error for files not on disk.
+var Offline bool
+
+// DefaultClient is the HTTP client used to retrieve files when none is
+// provided.
+var DefaultClient = &http.Client{
+	Timeout: 2 * time.Minute,
 }
 
+// Retry settings for transient failures (ie, timeouts, temporary network
+// errors, reset connections, and 429 or 5xx responses). Failed requests are retried up to Retries times, waiting
+// RetryWait before the first retry, and doubling the wait for each subsequent
+// retry. A Retry-After header sent by the server overrides the wait.
+var (
+	Retries   = 3
+	RetryWait = 1 * time.Second
+)
+
 // Get retrieves a file from disk or from the remote URL, optionally base64
-// decoding it and writing it to disk.
-func Get(c Cache) ([]byte, error) {
+// decoding it and writing it to disk along with its metadata (see Meta).
+//
+// Files on disk retrieved longer ago than the TTL are revalidated with the
+// remote URL using the ETag and Last-Modified headers of the previous
+// response, and are only retrieved again when changed. Files on disk not
+// matching the SHA-256 recorded in their metadata are retrieved again. Files
+// are written atomically, so an interrupted retrieval never leaves a
+// partially written file on disk.
+func Get(ctx context.Context, c Cache) ([]byte, error) {
 	var err error
 
 	if err = os.MkdirAll(filepath.Dir(c.Path), 0755); err != nil {
@@ -27,42 +67,313 @@ func Get(c Cache) ([]byte, error) {
 	}
 
 	// check if exists on disk
-	fi, err := os.Stat(c.Path)
-	if err == nil && c.TTL != 0 && !time.Now().After(fi.ModTime().Add(c.TTL)) {
-		return ioutil.ReadFile(c.Path)
+	buf, meta, err := ReadEntry(c.Path)
+	cached := err == nil
+	if err != nil && !os.IsNotExist(err) {
+		Logf("INVALID: %v", err)
+	}
+	if cached && (Offline || (c.TTL != 0 && !time.Now().After(meta.Fetched.Add(c.TTL)))) {
+		return buf, nil
+	}
+	if Offline {
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("offline: %v", err)
+		}
+		return nil, fmt.Errorf("offline: %s is not cached", c.URL)
+	}
+
+	// build conditional request headers
+	header := make(http.Header)
+	if cached && meta.URL == c.URL {
+		if meta.ETag != "" {
+			header.Set("If-None-Match", meta.ETag)
+		}
+		if meta.LastModified != "" {
+			header.Set("If-Modified-Since", meta.LastModified)
+		}
 	}
 
 	Logf("RETRIEVING: %s", c.URL)
 
 	// retrieve
-	cl := &http.Client{}
-	req, err := http.NewRequest("GET", c.URL, nil)
+	res, body, err := fetch(ctx, c.Client, c.URL, header)
 	if err != nil {
 		return nil, err
 	}
+
+	// not modified
+	if res.StatusCode == http.StatusNotModified {
+		Logf("NOT MODIFIED: %s", c.URL)
+		meta.Fetched = time.Now()
+		if err = writeMeta(c.Path, meta); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}
+
+	// decode
+	if c.Decode {
+		body, err = base64.StdEncoding.DecodeString(string(body))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", c.URL, err)
+		}
+	}
+
+	Logf("WRITING: %s", c.Path)
+	if err = WriteEntry(c.Path, body, Meta{
+		URL:          c.URL,
+		ETag:         res.Header.Get("ETag"),
+		LastModified: res.Header.Get("Last-Modified"),
+	}); err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+// MetaExt is the file extension of the metadata file stored alongside each
+// cached file.
+const MetaExt = ".meta"
+
+// Meta is the metadata of a cached file, stored as JSON alongside the file in
+// a metadata file having the same name with MetaExt appended.
+type Meta struct {
+	// URL is the remote URL the file was retrieved from. Empty for files
+	// generated locally.
+	URL string `json:"url,omitempty"`
+
+	// Fetched is when the file was retrieved or last revalidated.
+	Fetched time.Time `json:"fetched"`
+
+	// ETag and LastModified are the HTTP validators of the response.
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+
+	// SHA256 is the hex encoded SHA-256 of the file's contents.
+	SHA256 string `json:"sha256"`
+
+	// Size is the size of the file.
+	Size int64 `json:"size"`
+}
+
+// ReadEntry reads the named cached file and its metadata, verifying the
+// file's contents against the metadata. For a file without metadata, the
+// metadata's Fetched is the file's modification time.
+func ReadEntry(name string) ([]byte, Meta, error) {
+	var meta Meta
+	fi, err := os.Stat(name)
+	if err != nil {
+		return nil, meta, err
+	}
+	buf, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, meta, err
+	}
+	metaBuf, err := ioutil.ReadFile(name + MetaExt)
+	switch {
+	case os.IsNotExist(err):
+		return buf, Meta{Fetched: fi.ModTime(), Size: int64(len(buf))}, nil
+	case err != nil:
+		return nil, meta, err
+	}
+	if err = json.Unmarshal(metaBuf, &meta); err != nil {
+		return nil, meta, fmt.Errorf("%s: invalid metadata: %v", name, err)
+	}
+	if err = meta.Check(buf); err != nil {
+		return nil, meta, fmt.Errorf("%s: %v", name, err)
+	}
+	return buf, meta, nil
+}
+
+// Check checks that buf matches the size and SHA-256 of the metadata.
+func (meta Meta) Check(buf []byte) error {
+	if int64(len(buf)) != meta.Size {
+		return fmt.Errorf("size %d does not match recorded size %d", len(buf), meta.Size)
+	}
+	if sum := sha256Hex(buf); sum != meta.SHA256 {
+		return fmt.Errorf("sha256 %s does not match recorded sha256 %s", sum, meta.SHA256)
+	}
+	return nil
+}
+
+// WriteEntry atomically writes buf to the named cached file, along with its
+// metadata. The metadata's SHA256 and Size are set from buf, and Fetched is
+// set to the current time when not set.
+func WriteEntry(name string, buf []byte, meta Meta) error {
+	if meta.Fetched.IsZero() {
+		meta.Fetched = time.Now()
+	}
+	meta.SHA256, meta.Size = sha256Hex(buf), int64(len(buf))
+	if err := WriteFile(name, buf); err != nil {
+		return err
+	}
+	return writeMeta(name, meta)
+}
+
+// writeMeta atomically writes the metadata of the named cached file.
+func writeMeta(name string, meta Meta) error {
+	buf, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return err
+	}
+	return WriteFile(name+MetaExt, append(buf, '\n'))
+}
+
+// sha256Hex returns the hex encoded SHA-256 of buf.
+func sha256Hex(buf []byte) string {
+	sum := sha256.Sum256(buf)
+	return hex.EncodeToString(sum[:])
+}
+
+// StatusError is the error returned for an unexpected HTTP response status.
+type StatusError struct {
+	URL        string
+	StatusCode int
+	Status     string
+
+	// retryAfter is the wait requested by the server's Retry-After header.
+	retryAfter time.Duration
+}
+
+// Error satisfies the error interface.
+func (err *StatusError) Error() string {
+	return fmt.Sprintf("%s: unexpected status %s", err.URL, err.Status)
+}
+
+// Temporary returns true when the status indicates a transient failure (ie,
+// 429 or 5xx).
+func (err *StatusError) Temporary() bool {
+	return err.StatusCode == http.StatusTooManyRequests || err.StatusCode >= 500
+}
+
+// fetch retrieves the remote URL using the client, sending the additional
+// request headers, and retrying transient failures. Returns a StatusError for
+// responses other than 2xx, or 304 when the request is conditional.
+func fetch(ctx context.Context, cl *http.Client, urlstr string, header http.Header) (*http.Response, []byte, error) {
+	if cl == nil {
+		cl = DefaultClient
+	}
+	wait := RetryWait
+	for i := 0; ; i++ {
+		res, buf, err := fetchOnce(ctx, cl, urlstr, header)
+		if err == nil || i >= Retries || ctx.Err() != nil {
+			return res, buf, err
+		}
+		d := wait
+		switch e := err.(type) {
+		case *StatusError:
+			if !e.Temporary() {
+				return nil, nil, err
+			}
+			if e.retryAfter != 0 {
+				d = e.retryAfter
+			}
+		default:
+			if !retryable(err) {
+				return nil, nil, err
+			}
+		}
+		Logf("RETRYING: %s in %v (%v)", urlstr, d, err)
+		t := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil, nil, ctx.Err()
+		case <-t.C:
+		}
+		wait *= 2
+	}
+}
+
+// fetchOnce retrieves the remote URL using the client.
+func fetchOnce(ctx context.Context, cl *http.Client, urlstr string, header http.Header) (*http.Response, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", urlstr, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
 	res, err := cl.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer res.Body.Close()
 
+	conditional := header.Get("If-None-Match") != "" || header.Get("If-Modified-Since") != ""
+	switch {
+	case res.StatusCode == http.StatusNotModified && conditional:
+		return res, nil, nil
+	case res.StatusCode < 200 || res.StatusCode > 299:
+		// drain body to allow connection reuse
+		_, _ = io.Copy(ioutil.Discard, io.LimitReader(res.Body, 64*1024))
+		err := &StatusError{
+			URL:        urlstr,
+			StatusCode: res.StatusCode,
+			Status:     res.Status,
+		}
+		err.retryAfter = retryAfter(res.Header.Get("Retry-After"), time.Now())
+		return nil, nil, err
+	}
+
 	buf, err := ioutil.ReadAll(res.Body)
 	if err != nil {
-		return nil, err
+		return nil, nil, fmt.Errorf("%s: %w", urlstr, err)
 	}
+	return res, buf, nil
+}
 
-	// decode
-	if c.Decode {
-		buf, err = base64.StdEncoding.DecodeString(string(buf))
-		if err != nil {
-			return nil, err
+// retryAfter returns the wait requested by a Retry-After header value, either
+// as a number of seconds or as an HTTP date, relative to now. Returns 0 when
+// the value is empty, invalid, or not in the future.
+func retryAfter(s string, now time.Time) time.Duration {
+	if secs, err := strconv.Atoi(s); err == nil {
+		if secs > 0 {
+			return time.Duration(secs) * time.Second
 		}
+		return 0
+	}
+	if t, err := http.ParseTime(s); err == nil && t.After(now) {
+		return t.Sub(now)
 	}
+	return 0
+}
 
-	Logf("WRITING: %s", c.Path)
-	if err = ioutil.WriteFile(c.Path, buf, 0644); err != nil {
-		return nil, err
+// retryable determines if a request error is transient (ie, a timeout, a
+// temporary network error, or a connection reset or closed by the server).
+// Other errors (ie, an invalid URL, or a TLS certificate error) are permanent.
+func retryable(err error) bool {
+	switch {
+	case errors.Is(err, syscall.ECONNRESET), errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return true
 	}
+	var e net.Error
+	return errors.As(err, &e) && (e.Timeout() || e.Temporary())
+}
 
-	return buf, nil
+// WriteFile writes the named file atomically, by writing buf to a temporary
+// file in the same directory and renaming it.
+func WriteFile(name string, buf []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(name), "."+filepath.Base(name)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(buf); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err = f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if err = os.Chmod(f.Name(), 0644); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if err = os.Rename(f.Name(), name); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return nil
 }
@@ -0,0 +1,354 @@
+package util
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestGetRetry(t *testing.T) {
+	defer func(logf func(string, ...interface{}), retries int, wait time.Duration) {
+		Logf, Retries, RetryWait = logf, retries, wait
+	}(Logf, Retries, RetryWait)
+	Logf, Retries, RetryWait = t.Logf, 3, time.Millisecond
+
+	// respond with the statuses in order, then with the file
+	var count int32
+	newServer := func(statuses ...int) *httptest.Server {
+		count = 0
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if i := int(atomic.AddInt32(&count, 1)) - 1; i < len(statuses) {
+				http.Error(w, http.StatusText(statuses[i]), statuses[i])
+				return
+			}
+			_, _ = w.Write([]byte("file"))
+		}))
+	}
+
+	tests := []struct {
+		name     string
+		statuses []int
+		requests int32
+		status   int
+	}{
+		{"ok", nil, 1, 0},
+		{"5xx then 200", []int{500, 503}, 3, 0},
+		{"429 then 200", []int{429}, 2, 0},
+		{"404", []int{404}, 1, 404},
+		{"403", []int{403}, 1, 403},
+		{"5xx exhausted", []int{500, 502, 503, 504}, 4, 504},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := tempDir(t)
+			defer os.RemoveAll(dir)
+			s := newServer(test.statuses...)
+			defer s.Close()
+
+			name := filepath.Join(dir, "file")
+			buf, err := Get(context.Background(), Cache{URL: s.URL, Path: name})
+			if n := atomic.LoadInt32(&count); n != test.requests {
+				t.Errorf("expected %d requests, got: %d", test.requests, n)
+			}
+			if test.status != 0 {
+				e, ok := err.(*StatusError)
+				if !ok || e.StatusCode != test.status {
+					t.Fatalf("expected status %d error, got: %v", test.status, err)
+				}
+				if _, err := os.Stat(name); !os.IsNotExist(err) {
+					t.Errorf("expected no file, got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(buf) != "file" {
+				t.Errorf("expected file, got: %q", buf)
+			}
+			checkEntry(t, name, "file")
+		})
+	}
+}
+
+func TestGetRetryAfter(t *testing.T) {
+	defer func(logf func(string, ...interface{}), retries int, wait time.Duration) {
+		Logf, Retries, RetryWait = logf, retries, wait
+	}(Logf, Retries, RetryWait)
+	Logf, Retries, RetryWait = t.Logf, 1, time.Hour
+
+	// the server's wait overrides the (hour long) retry wait
+	var count int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&count, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("file"))
+	}))
+	defer s.Close()
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	start := time.Now()
+	buf, err := Get(ctx, Cache{URL: s.URL, Path: filepath.Join(dir, "file")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "file" || count != 2 {
+		t.Errorf("expected file after 2 requests, got: %q after %d", buf, count)
+	}
+	if d := time.Since(start); d < time.Second {
+		t.Errorf("expected to wait at least 1s, waited: %v", d)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		s   string
+		exp time.Duration
+	}{
+		{"", 0},
+		{"0", 0},
+		{"-5", 0},
+		{"120", 2 * time.Minute},
+		{"Tue, 02 Jan 2024 03:04:35 GMT", 30 * time.Second},
+		{"Tuesday, 02-Jan-24 03:05:05 GMT", time.Minute},
+		{"Tue Jan  2 03:04:15 2024", 10 * time.Second},
+		{"Tue, 02 Jan 2024 03:04:05 GMT", 0},
+		{"Mon, 01 Jan 2024 00:00:00 GMT", 0},
+		{"soon", 0},
+	}
+	for _, test := range tests {
+		if d := retryAfter(test.s, now); d != test.exp {
+			t.Errorf("%q expected %v, got: %v", test.s, test.exp, d)
+		}
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		exp  bool
+	}{
+		{"timeout", &url.Error{Op: "Get", URL: "u", Err: timeoutError{}}, true},
+		{"reset", &url.Error{Op: "Get", URL: "u", Err: &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}}, true},
+		{"closed", &url.Error{Op: "Get", URL: "u", Err: io.EOF}, true},
+		{"refused", &url.Error{Op: "Get", URL: "u", Err: &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}}, false},
+		{"x509", &url.Error{Op: "Get", URL: "u", Err: x509.UnknownAuthorityError{}}, false},
+		{"invalid url", &url.Error{Op: "parse", URL: "u", Err: errors.New("invalid port")}, false},
+		{"other", errors.New("other"), false},
+	}
+	for _, test := range tests {
+		if v := retryable(test.err); v != test.exp {
+			t.Errorf("%s expected %t, got: %t", test.name, test.exp, v)
+		}
+	}
+}
+
+func TestGetNoRetry(t *testing.T) {
+	defer func(logf func(string, ...interface{}), retries int, wait time.Duration) {
+		Logf, Retries, RetryWait = logf, retries, wait
+	}(Logf, Retries, RetryWait)
+	Logf, Retries, RetryWait = t.Logf, 3, time.Hour
+
+	// permanent failures return without waiting to retry
+	var count int32
+	s := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+	}))
+	s.Config.ErrorLog = log.New(ioutil.Discard, "", 0)
+	s.StartTLS()
+	defer s.Close()
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	for _, urlstr := range []string{s.URL, "http://127.0.0.1:bad/"} {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		_, err := Get(ctx, Cache{URL: urlstr, Path: filepath.Join(dir, "file"), Client: &http.Client{}})
+		if err == nil || ctx.Err() != nil {
+			t.Errorf("%s expected error without retrying, got: %v", urlstr, err)
+		}
+		cancel()
+	}
+	if count != 0 {
+		t.Errorf("expected no requests, got: %d", count)
+	}
+}
+
+func TestGetCancel(t *testing.T) {
+	defer func(logf func(string, ...interface{}), retries int, wait time.Duration) {
+		Logf, Retries, RetryWait = logf, retries, wait
+	}(Logf, Retries, RetryWait)
+	Logf, Retries, RetryWait = t.Logf, 3, time.Hour
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer s.Close()
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	start := time.Now()
+	if _, err := Get(ctx, Cache{URL: s.URL, Path: filepath.Join(dir, "file")}); err != context.Canceled {
+		t.Errorf("expected context.Canceled, got: %v", err)
+	}
+	if d := time.Since(start); d > 10*time.Second {
+		t.Errorf("expected cancel to stop waiting, waited: %v", d)
+	}
+}
+
+func TestGetRevalidate(t *testing.T) {
+	defer func(logf func(string, ...interface{})) { Logf = logf }(Logf)
+	Logf = t.Logf
+
+	const etag, lastModified = `"v1"`, "Tue, 02 Jan 2024 03:04:05 GMT"
+	var count, notModified int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		if r.Header.Get("If-None-Match") == etag && r.Header.Get("If-Modified-Since") == lastModified {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Last-Modified", lastModified)
+		_, _ = w.Write([]byte("file"))
+	}))
+	defer s.Close()
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	c := Cache{URL: s.URL, Path: filepath.Join(dir, "file"), TTL: time.Hour}
+
+	// retrieve
+	if _, err := Get(context.Background(), c); err != nil {
+		t.Fatal(err)
+	}
+	_, meta := checkEntry(t, c.Path, "file")
+	if meta.ETag != etag || meta.LastModified != lastModified || meta.URL != s.URL {
+		t.Errorf("expected validators to be recorded, got: %#v", meta)
+	}
+
+	// within ttl
+	if _, err := Get(context.Background(), c); err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("expected 1 request, got: %d", count)
+	}
+
+	// expired
+	meta.Fetched = meta.Fetched.Add(-2 * time.Hour)
+	if err := writeMeta(c.Path, meta); err != nil {
+		t.Fatal(err)
+	}
+	buf, err := Get(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "file" || count != 2 || notModified != 1 {
+		t.Errorf("expected file revalidated by second request, got: %q after %d (%d not modified)", buf, count, notModified)
+	}
+	if _, z := checkEntry(t, c.Path, "file"); !z.Fetched.After(meta.Fetched.Add(time.Hour)) {
+		t.Errorf("expected fetched to be updated, got: %v", z.Fetched)
+	}
+
+	// changed file is retrieved again
+	if err := ioutil.WriteFile(c.Path, []byte("changed"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if buf, err = Get(context.Background(), c); err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "file" || count != 3 || notModified != 1 {
+		t.Errorf("expected file retrieved by third request, got: %q after %d (%d not modified)", buf, count, notModified)
+	}
+}
+
+func TestWriteFile(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "file")
+	for _, s := range []string{"a", "bb"} {
+		if err := WriteFile(name, []byte(s)); err != nil {
+			t.Fatal(err)
+		}
+		buf, err := ioutil.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(buf) != s {
+			t.Errorf("expected %q, got: %q", s, buf)
+		}
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].Mode().Perm() != 0644 {
+		t.Errorf("expected only file with mode 0644, got: %v", files)
+	}
+	if err := WriteFile(filepath.Join(dir, "missing", "file"), nil); err == nil {
+		t.Error("expected error, got none")
+	}
+}
+
+// checkEntry checks that the named cached file contains s, and that it and
+// its metadata file are the only files in its directory (ie, no temporary
+// files were left behind), returning its contents and metadata.
+func checkEntry(t *testing.T, name, s string) ([]byte, Meta) {
+	t.Helper()
+	buf, meta, err := ReadEntry(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != s {
+		t.Errorf("expected %q, got: %q", s, buf)
+	}
+	files, err := ioutil.ReadDir(filepath.Dir(name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, fi := range files {
+		if n := fi.Name(); n != filepath.Base(name) && n != filepath.Base(name)+MetaExt {
+			t.Errorf("unexpected file %s", n)
+		}
+	}
+	return buf, meta
+}
+
+// tempDir creates a temporary directory.
+func tempDir(t *testing.T) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "cdproto-gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+// timeoutError is a net.Error timing out.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
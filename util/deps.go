@@ -0,0 +1,377 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Deps is a parsed gclient DEPS file.
+//
+// DEPS files are Python-like: a sequence of top-level assignments whose
+// values are dicts, lists, strings, integers, booleans, and None, with string
+// concatenation (+ or adjacent string literals), and references to the vars
+// dict via Var('name') (and Str('value') for string vars).
+type Deps struct {
+	// Globals are the evaluated top-level assignments (ie, vars and deps).
+	// Values are string, int64, bool, nil, []interface{}, or
+	// map[string]interface{}.
+	Globals map[string]interface{}
+}
+
+// ParseDeps parses and evaluates the contents of a DEPS file.
+func ParseDeps(buf []byte) (*Deps, error) {
+	p := &depsParser{
+		s:    string(buf),
+		line: 1,
+		deps: &Deps{Globals: make(map[string]interface{})},
+	}
+	if err := p.parse(); err != nil {
+		return nil, fmt.Errorf("DEPS:%d: %v", p.line, err)
+	}
+	return p.deps, nil
+}
+
+// Vars returns the vars dict.
+func (d *Deps) Vars() map[string]interface{} {
+	vars, _ := d.Globals["vars"].(map[string]interface{})
+	return vars
+}
+
+// depsVarRE matches a {var} reference in a deps url.
+var depsVarRE = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// Revision returns the revision of the typ dependency (ie, v8), either from
+// the '<typ>_revision' var, or from the url of the 'src/<typ>' dependency.
+func (d *Deps) Revision(typ string) (string, error) {
+	vars := d.Vars()
+	if v, ok := vars[typ+"_revision"]; ok {
+		s, ok := v.(string)
+		if !ok || s == "" {
+			return "", fmt.Errorf("invalid %s_revision var", typ)
+		}
+		return s, nil
+	}
+
+	deps, _ := d.Globals["deps"].(map[string]interface{})
+	for _, name := range []string{"src/" + typ, typ} {
+		dep, ok := deps[name]
+		if !ok {
+			continue
+		}
+		if m, ok := dep.(map[string]interface{}); ok {
+			dep = m["url"]
+		}
+		urlstr, ok := dep.(string)
+		if !ok {
+			return "", fmt.Errorf("invalid %s dependency url", name)
+		}
+		// expand {var} references
+		urlstr = depsVarRE.ReplaceAllStringFunc(urlstr, func(s string) string {
+			if v, ok := vars[s[1:len(s)-1]].(string); ok {
+				return v
+			}
+			return s
+		})
+		i := strings.LastIndex(urlstr, "@")
+		if i == -1 || i == len(urlstr)-1 {
+			return "", fmt.Errorf("%s dependency url %q has no revision", name, urlstr)
+		}
+		return urlstr[i+1:], nil
+	}
+	return "", errors.New("could not find revision")
+}
+
+// depsParser is a recursive descent parser and evaluator for DEPS files.
+type depsParser struct {
+	s    string
+	line int
+	deps *Deps
+}
+
+// parse parses the top-level assignments.
+func (p *depsParser) parse() error {
+	for {
+		p.skip()
+		if p.s == "" {
+			return nil
+		}
+		name := p.ident()
+		if name == "" {
+			return fmt.Errorf("unexpected %s", p.next())
+		}
+		if err := p.expect('='); err != nil {
+			return err
+		}
+		v, err := p.expr()
+		if err != nil {
+			return err
+		}
+		p.deps.Globals[name] = v
+	}
+}
+
+// skip skips whitespace and comments.
+func (p *depsParser) skip() {
+	for p.s != "" {
+		switch c := p.s[0]; {
+		case c == '\n':
+			p.line++
+			p.s = p.s[1:]
+		case c == '#':
+			i := strings.IndexByte(p.s, '\n')
+			if i == -1 {
+				i = len(p.s)
+			}
+			p.s = p.s[i:]
+		case c == ' ' || c == '\t' || c == '\r' || c == '\\':
+			p.s = p.s[1:]
+		default:
+			return
+		}
+	}
+}
+
+// next returns a description of the next token, for errors.
+func (p *depsParser) next() string {
+	if p.s == "" {
+		return "end of file"
+	}
+	s := p.s
+	if i := strings.IndexAny(s, " \t\r\n"); i != -1 {
+		s = s[:i]
+	}
+	if len(s) > 20 {
+		s = s[:20] + "..."
+	}
+	return strconv.Quote(s)
+}
+
+// peek returns true when the next character, after skipping whitespace and
+// comments, is c.
+func (p *depsParser) peek(c byte) bool {
+	p.skip()
+	return p.s != "" && p.s[0] == c
+}
+
+// expect consumes the next character, which must be c.
+func (p *depsParser) expect(c byte) error {
+	if !p.peek(c) {
+		return fmt.Errorf("expected %q, got %s", c, p.next())
+	}
+	p.s = p.s[1:]
+	return nil
+}
+
+// ident consumes an identifier, returning an empty string when the next
+// token is not an identifier.
+func (p *depsParser) ident() string {
+	p.skip()
+	i := 0
+	for i < len(p.s) && (p.s[i] == '_' || unicode.IsLetter(rune(p.s[i])) || (i > 0 && unicode.IsDigit(rune(p.s[i])))) {
+		i++
+	}
+	name := p.s[:i]
+	p.s = p.s[i:]
+	return name
+}
+
+// expr parses and evaluates an expression, concatenating any strings joined
+// by '+' or adjacent string literals.
+func (p *depsParser) expr() (interface{}, error) {
+	v, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var concat bool
+		switch {
+		case p.peek('+'):
+			p.s = p.s[1:]
+			concat = true
+		case p.peek('\'') || p.peek('"'):
+			concat = true
+		}
+		if !concat {
+			return v, nil
+		}
+		w, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		a, ok1 := v.(string)
+		b, ok2 := w.(string)
+		if !ok1 || !ok2 {
+			return nil, fmt.Errorf("cannot concatenate %T and %T", v, w)
+		}
+		v = a + b
+	}
+}
+
+// value parses and evaluates a single value.
+func (p *depsParser) value() (interface{}, error) {
+	p.skip()
+	if p.s == "" {
+		return nil, errors.New("unexpected end of file")
+	}
+	switch c := p.s[0]; {
+	case c == '\'' || c == '"':
+		return p.str()
+	case c == '{':
+		return p.dict()
+	case c == '[' || c == '(':
+		return p.list()
+	case c == '-' || ('0' <= c && c <= '9'):
+		return p.number()
+	}
+
+	name := p.ident()
+	switch name {
+	case "True":
+		return true, nil
+	case "False":
+		return false, nil
+	case "None":
+		return nil, nil
+	case "Var", "Str":
+		if err := p.expect('('); err != nil {
+			return nil, err
+		}
+		arg, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expect(')'); err != nil {
+			return nil, err
+		}
+		s, ok := arg.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s() argument must be a string", name)
+		}
+		if name == "Str" {
+			return s, nil
+		}
+		v, ok := p.deps.Vars()[s]
+		if !ok {
+			return nil, fmt.Errorf("undefined var %q", s)
+		}
+		return v, nil
+	case "":
+		return nil, fmt.Errorf("unexpected %s", p.next())
+	}
+	// reference to a previous assignment
+	if v, ok := p.deps.Globals[name]; ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("undefined name %q", name)
+}
+
+// str parses a single, double, or triple quoted string literal.
+func (p *depsParser) str() (string, error) {
+	quote := p.s[:1]
+	if strings.HasPrefix(p.s, quote+quote+quote) {
+		quote = p.s[:3]
+	}
+	p.s = p.s[len(quote):]
+	var b strings.Builder
+	for {
+		switch {
+		case p.s == "":
+			return "", errors.New("unterminated string")
+		case strings.HasPrefix(p.s, quote):
+			p.s = p.s[len(quote):]
+			return b.String(), nil
+		case p.s[0] == '\n' && len(quote) == 1:
+			return "", errors.New("unterminated string")
+		case p.s[0] == '\\' && len(p.s) > 1:
+			switch c := p.s[1]; c {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case '\n':
+				p.line++
+			case '\\', '\'', '"':
+				b.WriteByte(c)
+			default:
+				b.WriteString(p.s[:2])
+			}
+			p.s = p.s[2:]
+		default:
+			if p.s[0] == '\n' {
+				p.line++
+			}
+			b.WriteByte(p.s[0])
+			p.s = p.s[1:]
+		}
+	}
+}
+
+// dict parses a dict.
+func (p *depsParser) dict() (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	p.s = p.s[1:]
+	for !p.peek('}') {
+		k, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := k.(string)
+		if !ok {
+			return nil, fmt.Errorf("dict key must be a string, got %T", k)
+		}
+		if err = p.expect(':'); err != nil {
+			return nil, err
+		}
+		if m[key], err = p.expr(); err != nil {
+			return nil, err
+		}
+		if !p.peek(',') {
+			break
+		}
+		p.s = p.s[1:]
+	}
+	return m, p.expect('}')
+}
+
+// list parses a list or tuple.
+func (p *depsParser) list() ([]interface{}, error) {
+	end := byte(']')
+	if p.s[0] == '(' {
+		end = ')'
+	}
+	p.s = p.s[1:]
+	var v []interface{}
+	for !p.peek(end) {
+		z, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		v = append(v, z)
+		if !p.peek(',') {
+			break
+		}
+		p.s = p.s[1:]
+	}
+	return v, p.expect(end)
+}
+
+// number parses an integer. Floats are not supported.
+func (p *depsParser) number() (int64, error) {
+	i := 1
+	for i < len(p.s) && '0' <= p.s[i] && p.s[i] <= '9' {
+		i++
+	}
+	if i < len(p.s) && (p.s[i] == '.' || p.s[i] == 'e' || p.s[i] == 'E') {
+		return 0, fmt.Errorf("unsupported float %s", p.next())
+	}
+	n, err := strconv.ParseInt(p.s[:i], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", p.s[:i])
+	}
+	p.s = p.s[i:]
+	return n, nil
+}
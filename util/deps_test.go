@@ -0,0 +1,290 @@
+package util
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testDeps is a trimmed Chromium DEPS file.
+const testDeps = `# This file is used to manage the dependencies of the Chromium src repo. It is
+# used by gclient to determine what version of each dependency to check out, and
+# where.
+
+gclient_gn_args_file = 'src/build/config/gclient_args.gni'
+gclient_gn_args = [
+  'build_with_chromium',
+  'checkout_android',
+  'checkout_nacl',
+]
+
+use_relative_paths = False
+
+vars = {
+  # Variable that can be used to support multiple build scenarios, like having
+  # Chromium specific targets in a client project's GN file or sync dependencies
+  # conditionally etc.
+  'build_with_chromium': True,
+
+  'checkout_android': False,
+  'checkout_nacl': True,
+  'checkout_src_internal': False,
+
+  # Fetches only the SDK boot images which match at least one of the whitelisted
+  # entries in a comma-separated list.
+  'checkout_fuchsia_boot_images': "terminal.x64,"
+                                  "terminal.qemu-arm64",
+
+  'chromium_git': 'https://chromium.googlesource.com',
+  'swiftshader_git': 'https://swiftshader.googlesource.com',
+  'webrtc_git': 'https://webrtc.googlesource.com',
+
+  'android_sdk_platform-tools_version': 'HWVsGs2HCKgSVv41FsOcsfJbNcB0UFiNrF6Tc4yRArYC',
+  'chromium_variations_revision': '1bbab2c3a8a0d9f10b8e2a3c6b4e6b1d2c3a4b5c',
+  'dawn_standalone': Str('false'),
+  'reclient_version': 're_client_version:0.126.0.4aaef37-gomaip',
+
+  # Three lines of non-changing comments so that
+  # the commit queue can handle CLs rolling V8
+  # and whatever else without interference from each other.
+  'v8_revision': '5d1b4d6a7e3f0c2b9a8d7e6f5a4b3c2d1e0f9a8b',
+  # Three lines of non-changing comments so that
+  # the commit queue can handle CLs rolling Skia
+  # and whatever else without interference from each other.
+  'skia_revision': 'a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9',
+}
+
+# Only these hosts are allowed for dependencies in this DEPS file.
+# If you need to add a new host, contact chrome infrastructure team.
+allowed_hosts = [
+  'chromium.googlesource.com',
+  'chrome-infra-packages.appspot.com',
+]
+
+deps = {
+  'src/buildtools/reclient': {
+    'packages': [
+      {
+        'package': 'infra/rbe/client/${{platform}}',
+        'version': Var('reclient_version'),
+      }
+    ],
+    'dep_type': 'cipd',
+    'condition': 'not (host_os == "linux" and host_cpu == "arm64")',
+  },
+
+  'src/third_party/android_sdk/public': {
+      'packages': [
+          {
+              'package': 'chromium/third_party/android_sdk/public/platform-tools',
+              'version': Var('android_sdk_platform-tools_version'),
+          },
+      ],
+      'condition': 'checkout_android',
+      'dep_type': 'cipd',
+  },
+
+  'src/third_party/chromium-variations': {
+    'url': Var('chromium_git') + '/chromium-variations.git' + '@' + Var('chromium_variations_revision'),
+  },
+
+  'src/third_party/skia':
+    Var('chromium_git') + '/skia.git' + '@' +  Var('skia_revision'),
+
+  'src/third_party/swiftshader':
+    Var('swiftshader_git') + '/SwiftShader.git' + '@' +  'e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e9f0',
+
+  'src/third_party/webrtc':
+    '{webrtc_git}/src.git@f0e9d8c7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f2e1',
+
+  'src/third_party/nacl_sdk_binaries': {
+    'url': '{chromium_git}/native_client/sdk.git@{chromium_variations_revision}',
+    'condition': 'checkout_nacl and checkout_win',
+  },
+
+  'src/v8':
+    Var('chromium_git') + '/v8/v8.git' + '@' +  Var('v8_revision'),
+}
+
+hooks = [
+  {
+    # This clobbers when necessary (based on get_landmines.py). It must be the
+    # first hook so that other things that get/generate into the output
+    # directory will not subsequently be clobbered.
+    'name': 'landmines',
+    'pattern': '.',
+    'action': [
+        'python3',
+        'src/build/landmines.py',
+        '--landmine-scripts',
+        'src/tools/remove_stale_pyc_files.py',
+    ],
+  },
+  {
+    'name': 'sysroot_x64',
+    'pattern': '.',
+    'condition': 'checkout_linux and checkout_x64',
+    'action': ['python3', 'src/build/linux/sysroot_scripts/install-sysroot.py',
+               '--arch=x64'],
+  },
+]
+
+# Note: the DEPS file does not need to explicitly list the recursion
+# dependencies (i.e. recursedeps) for all repos that are fetched.
+recursedeps = [
+  'src/third_party/skia',
+  ('src/v8', 'DEPS'),
+]
+`
+
+func TestParseDeps(t *testing.T) {
+	d, err := ParseDeps([]byte(testDeps))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// vars
+	vars := d.Vars()
+	for k, exp := range map[string]interface{}{
+		"build_with_chromium":          true,
+		"checkout_android":             false,
+		"checkout_fuchsia_boot_images": "terminal.x64,terminal.qemu-arm64",
+		"dawn_standalone":              "false",
+		"v8_revision":                  "5d1b4d6a7e3f0c2b9a8d7e6f5a4b3c2d1e0f9a8b",
+	} {
+		if v := vars[k]; v != exp {
+			t.Errorf("expected var %s to be %#v, got: %#v", k, exp, v)
+		}
+	}
+
+	// globals
+	if v := d.Globals["use_relative_paths"]; v != false {
+		t.Errorf("expected use_relative_paths false, got: %#v", v)
+	}
+	if v, exp := d.Globals["gclient_gn_args"], []interface{}{"build_with_chromium", "checkout_android", "checkout_nacl"}; !reflect.DeepEqual(v, exp) {
+		t.Errorf("expected gclient_gn_args %#v, got: %#v", exp, v)
+	}
+
+	// deps
+	deps := d.Globals["deps"].(map[string]interface{})
+	if v, exp := deps["src/third_party/skia"], "https://chromium.googlesource.com/skia.git@a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9"; v != exp {
+		t.Errorf("expected skia dependency %q, got: %#v", exp, v)
+	}
+	nacl := deps["src/third_party/nacl_sdk_binaries"].(map[string]interface{})
+	if v, exp := nacl["condition"], "checkout_nacl and checkout_win"; v != exp {
+		t.Errorf("expected condition %q, got: %#v", exp, v)
+	}
+
+	// cipd packages
+	sdk := deps["src/third_party/android_sdk/public"].(map[string]interface{})
+	if sdk["dep_type"] != "cipd" || sdk["condition"] != "checkout_android" {
+		t.Errorf("unexpected android sdk dependency %#v", sdk)
+	}
+	pkg := sdk["packages"].([]interface{})[0].(map[string]interface{})
+	if v, exp := pkg["version"], "HWVsGs2HCKgSVv41FsOcsfJbNcB0UFiNrF6Tc4yRArYC"; v != exp {
+		t.Errorf("expected package version %q, got: %#v", exp, v)
+	}
+	reclient := deps["src/buildtools/reclient"].(map[string]interface{})
+	if v, exp := reclient["condition"], `not (host_os == "linux" and host_cpu == "arm64")`; v != exp {
+		t.Errorf("expected condition %q, got: %#v", exp, v)
+	}
+	pkg = reclient["packages"].([]interface{})[0].(map[string]interface{})
+	if v, exp := pkg["package"], "infra/rbe/client/${{platform}}"; v != exp {
+		t.Errorf("expected package %q, got: %#v", exp, v)
+	}
+
+	// hooks
+	hooks := d.Globals["hooks"].([]interface{})
+	if len(hooks) != 2 {
+		t.Fatalf("expected 2 hooks, got: %d", len(hooks))
+	}
+	hook := hooks[1].(map[string]interface{})
+	exp := []interface{}{"python3", "src/build/linux/sysroot_scripts/install-sysroot.py", "--arch=x64"}
+	if hook["name"] != "sysroot_x64" || !reflect.DeepEqual(hook["action"], exp) {
+		t.Errorf("unexpected hook %#v", hook)
+	}
+
+	// recursedeps
+	if v, exp := d.Globals["recursedeps"], []interface{}{"src/third_party/skia", []interface{}{"src/v8", "DEPS"}}; !reflect.DeepEqual(v, exp) {
+		t.Errorf("expected recursedeps %#v, got: %#v", exp, v)
+	}
+}
+
+func TestDepsRevision(t *testing.T) {
+	d, err := ParseDeps([]byte(testDeps))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		typ string
+		exp string
+		err string
+	}{
+		// <typ>_revision var
+		{"v8", "5d1b4d6a7e3f0c2b9a8d7e6f5a4b3c2d1e0f9a8b", ""},
+		{"skia", "a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9", ""},
+		// Var concatenation
+		{"third_party/swiftshader", "e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e9f0", ""},
+		// url in dict
+		{"third_party/chromium-variations", "1bbab2c3a8a0d9f10b8e2a3c6b4e6b1d2c3a4b5c", ""},
+		// {var} interpolation
+		{"third_party/webrtc", "f0e9d8c7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f2e1", ""},
+		{"third_party/nacl_sdk_binaries", "1bbab2c3a8a0d9f10b8e2a3c6b4e6b1d2c3a4b5c", ""},
+		// errors
+		{"third_party/android_sdk/public", "", "invalid src/third_party/android_sdk/public dependency url"},
+		{"missing", "", "could not find revision"},
+	}
+	for _, test := range tests {
+		rev, err := d.Revision(test.typ)
+		switch {
+		case test.err != "" && (err == nil || err.Error() != test.err):
+			t.Errorf("%s expected error %q, got: %v", test.typ, test.err, err)
+		case test.err == "" && err != nil:
+			t.Errorf("%s expected no error, got: %v", test.typ, err)
+		case rev != test.exp:
+			t.Errorf("%s expected %q, got: %q", test.typ, test.exp, rev)
+		}
+	}
+
+	// DepRevision
+	rev, err := DepRevision("v8", []byte(testDeps))
+	if err != nil || rev != "5d1b4d6a7e3f0c2b9a8d7e6f5a4b3c2d1e0f9a8b" {
+		t.Errorf("expected v8 revision, got: %q %v", rev, err)
+	}
+	if _, err := DepRevision("v8", []byte("vars = {\n  'v8_revision': '',\n}\n")); err == nil || err.Error() != "invalid v8_revision var" {
+		t.Errorf("expected invalid var error, got: %v", err)
+	}
+	if _, err := DepRevision("v8", []byte("deps = {\n  'src/v8': 'https://x/v8.git',\n}\n")); err == nil || !strings.Contains(err.Error(), "has no revision") {
+		t.Errorf("expected no revision error, got: %v", err)
+	}
+}
+
+func TestParseDepsErrors(t *testing.T) {
+	tests := []struct {
+		buf string
+		err string
+	}{
+		{"vars = {\n  'a': Var('b'),\n}\n", `DEPS:2: undefined var "b"`},
+		{"deps = {\n  'src/v8': Var('chromium_git') + '/v8.git',\n}\n", `DEPS:2: undefined var "chromium_git"`},
+		{"x = y\n", `DEPS:1: undefined name "y"`},
+		{"vars = {\n  'timeout': 1.5,\n}\n", `DEPS:2: unsupported float "1.5,"`},
+		{"x = 1e3\n", `DEPS:1: unsupported float "1e3"`},
+		{"x = 1 + 2\n", "DEPS:1: cannot concatenate int64 and int64"},
+		{"x = 'a' + True\n", "DEPS:1: cannot concatenate string and bool"},
+		{"x = Var(1)\n", "DEPS:1: Var() argument must be a string"},
+		{"x = {1: 'a'}\n", "DEPS:1: dict key must be a string, got int64"},
+		{"x = len('a')\n", `DEPS:1: undefined name "len"`},
+		{"x = 'a' if True else 'b'\n", `DEPS:1: expected '=', got "True"`},
+		{"x = {\n  'a': 'b'\n", "DEPS:3: expected '}', got end of file"},
+		{"x = 'abc\n", "DEPS:1: unterminated string"},
+		{"x = '''abc\n", "DEPS:2: unterminated string"},
+		{"= 1\n", `DEPS:1: unexpected "="`},
+		{"x =\n", "DEPS:2: unexpected end of file"},
+	}
+	for _, test := range tests {
+		_, err := ParseDeps([]byte(test.buf))
+		if err == nil || err.Error() != test.err {
+			t.Errorf("%q expected error %q, got: %v", test.buf, test.err, err)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// BrowserVersion is the browser version information reported by a DevTools
+// endpoint.
+type BrowserVersion struct {
+	// Browser is the browser name and version (ie, Chrome/120.0.6099.109).
+	Browser string `json:"Browser"`
+
+	// ProtocolVersion is the DevTools protocol version.
+	ProtocolVersion string `json:"Protocol-Version"`
+
+	// UserAgent is the browser's user agent.
+	UserAgent string `json:"User-Agent"`
+
+	// V8Version is the V8 version.
+	V8Version string `json:"V8-Version"`
+
+	// WebKitVersion is the WebKit (Blink) version.
+	WebKitVersion string `json:"WebKit-Version"`
+}
+
+// ChromeVersion returns the browser's version, without the browser name (ie,
+// 120.0.6099.109).
+func (v BrowserVersion) ChromeVersion() string {
+	return v.Browser[strings.LastIndex(v.Browser, "/")+1:]
+}
+
+// GetEndpointVersion retrieves the browser version information from the
+// DevTools endpoint (ie, http://localhost:9222), using the client (or
+// DefaultClient when nil).
+func GetEndpointVersion(ctx context.Context, client *http.Client, endpoint string) (*BrowserVersion, error) {
+	buf, err := getEndpoint(ctx, client, endpoint, "/json/version")
+	if err != nil {
+		return nil, err
+	}
+	var v BrowserVersion
+	if err = json.Unmarshal(buf, &v); err != nil {
+		return nil, fmt.Errorf("%s/json/version: %v", endpoint, err)
+	}
+	if v.Browser == "" {
+		return nil, fmt.Errorf("%s/json/version: missing browser version", endpoint)
+	}
+	return &v, nil
+}
+
+// GetEndpointProtocol retrieves the JSON protocol definitions of the browser
+// from the DevTools endpoint (ie, http://localhost:9222), using the client
+// (or DefaultClient when nil).
+func GetEndpointProtocol(ctx context.Context, client *http.Client, endpoint string) ([]byte, error) {
+	return getEndpoint(ctx, client, endpoint, "/json/protocol")
+}
+
+// getEndpoint retrieves the path from the DevTools endpoint.
+func getEndpoint(ctx context.Context, client *http.Client, endpoint, path string) ([]byte, error) {
+	urlstr := strings.TrimSuffix(endpoint, "/") + path
+	if Offline {
+		return nil, fmt.Errorf("offline: cannot retrieve %s", urlstr)
+	}
+	Logf("RETRIEVING: %s", urlstr)
+	_, buf, err := fetch(ctx, client, urlstr, nil)
+	return buf, err
+}
@@ -0,0 +1,125 @@
+package util
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// endpointVersion is a /json/version response in the format of a headless
+// Chrome.
+const endpointVersion = `{
+   "Browser": "HeadlessChrome/140.0.7339.207",
+   "Protocol-Version": "1.3",
+   "User-Agent": "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) HeadlessChrome/140.0.7339.207 Safari/537.36",
+   "V8-Version": "14.0.365.10",
+   "WebKit-Version": "537.36 (@0000000000000000000000000000000000000000)",
+   "webSocketDebuggerUrl": "ws://127.0.0.1:9222/devtools/browser/00000000-0000-0000-0000-000000000000"
+}`
+
+// newEndpoint starts a DevTools endpoint test server, serving the version and
+// protocol responses for each path prefix.
+func newEndpoint(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := strings.Index(r.URL.Path, "/json/")
+		if i == -1 {
+			http.NotFound(w, r)
+			return
+		}
+		prefix, path := r.URL.Path[:i], r.URL.Path[i:]
+		switch {
+		case prefix == "/missing":
+			http.NotFound(w, r)
+		case prefix == "/error":
+			http.Error(w, "internal error", http.StatusInternalServerError)
+		case prefix == "/bad":
+			_, _ = w.Write([]byte(`{"Browser":`))
+		case prefix == "/empty":
+			_, _ = w.Write([]byte(`{}`))
+		case path == "/json/version":
+			_, _ = w.Write([]byte(endpointVersion))
+		case path == "/json/protocol":
+			_, _ = w.Write([]byte(`{"version":{"major":"1","minor":"3"},"domains":[]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestGetEndpointVersion(t *testing.T) {
+	defer func(logf func(string, ...interface{}), retries int) { Logf, Retries = logf, retries }(Logf, Retries)
+	Logf, Retries = t.Logf, 0
+	s := newEndpoint(t)
+	defer s.Close()
+
+	// with and without trailing slash
+	for _, endpoint := range []string{s.URL, s.URL + "/"} {
+		v, err := GetEndpointVersion(context.Background(), nil, endpoint)
+		if err != nil {
+			t.Fatal(err)
+		}
+		exp := BrowserVersion{
+			Browser:         "HeadlessChrome/140.0.7339.207",
+			ProtocolVersion: "1.3",
+			UserAgent:       "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) HeadlessChrome/140.0.7339.207 Safari/537.36",
+			V8Version:       "14.0.365.10",
+			WebKitVersion:   "537.36 (@0000000000000000000000000000000000000000)",
+		}
+		if *v != exp {
+			t.Errorf("expected %#v, got: %#v", exp, *v)
+		}
+		if ver := v.ChromeVersion(); ver != "140.0.7339.207" {
+			t.Errorf("expected chrome version 140.0.7339.207, got: %s", ver)
+		}
+	}
+
+	// errors
+	tests := []struct {
+		prefix string
+		err    string
+	}{
+		{"/missing", "/missing/json/version: unexpected status 404 Not Found"},
+		{"/error", "/error/json/version: unexpected status 500 Internal Server Error"},
+		{"/bad", "/bad/json/version: unexpected end of JSON input"},
+		{"/empty", "/empty/json/version: missing browser version"},
+	}
+	for _, test := range tests {
+		_, err := GetEndpointVersion(context.Background(), nil, s.URL+test.prefix)
+		if err == nil || err.Error() != s.URL+test.err {
+			t.Errorf("expected error %q, got: %v", s.URL+test.err, err)
+		}
+	}
+	if _, err := GetEndpointVersion(context.Background(), nil, s.URL+"/missing"); err != nil {
+		if _, ok := err.(*StatusError); !ok {
+			t.Errorf("expected *StatusError, got: %T", err)
+		}
+	}
+}
+
+func TestGetEndpointProtocol(t *testing.T) {
+	defer func(logf func(string, ...interface{}), retries int) { Logf, Retries = logf, retries }(Logf, Retries)
+	Logf, Retries = t.Logf, 0
+	s := newEndpoint(t)
+	defer s.Close()
+
+	buf, err := GetEndpointProtocol(context.Background(), nil, s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(buf), `"domains":[]`) {
+		t.Errorf("unexpected protocol %q", buf)
+	}
+	if _, err := GetEndpointProtocol(context.Background(), nil, s.URL+"/error"); err == nil {
+		t.Error("expected error, got none")
+	}
+
+	// offline
+	Offline = true
+	defer func() { Offline = false }()
+	if _, err := GetEndpointProtocol(context.Background(), nil, s.URL); err == nil || !strings.HasPrefix(err.Error(), "offline:") {
+		t.Errorf("expected offline error, got: %v", err)
+	}
+}
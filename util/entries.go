@@ -0,0 +1,272 @@
+package util
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Entry is a file in a cache directory.
+type Entry struct {
+	// Name is the slash-separated path of the file, relative to the cache
+	// directory.
+	Name string
+
+	// Meta is the file's metadata. For files without a metadata file, only
+	// Fetched (the file's modification time) and Size are set.
+	Meta Meta
+
+	// HasMeta is true when the file has a metadata file.
+	HasMeta bool
+
+	// Missing is true when the file's metadata file exists, but the file does
+	// not.
+	Missing bool
+
+	// Err is the error reading the file's metadata file, when it could not be
+	// read or is invalid. Meta is then set as for a file without metadata.
+	Err error
+}
+
+// Entries returns the entries of the cache directory, sorted by name.
+// Temporary files are ignored. Metadata files that cannot be read are
+// recorded as the Err of their entry. Returns no entries when the cache
+// directory does not exist.
+func Entries(dir string) ([]Entry, error) {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil, nil
+	}
+	m := make(map[string]*Entry)
+	err := filepath.Walk(dir, func(n string, fi os.FileInfo, err error) error {
+		switch {
+		case err != nil:
+			return err
+		case fi.IsDir() || strings.HasPrefix(fi.Name(), "."):
+			return nil
+		}
+		rel, err := filepath.Rel(dir, n)
+		if err != nil {
+			return err
+		}
+		name, isMeta := filepath.ToSlash(rel), strings.HasSuffix(rel, MetaExt)
+		name = strings.TrimSuffix(name, MetaExt)
+		e, ok := m[name]
+		if !ok {
+			e = &Entry{Name: name, Missing: true}
+			m[name] = e
+		}
+		if !isMeta {
+			e.Missing = false
+			if !e.HasMeta {
+				e.Meta = Meta{Fetched: fi.ModTime(), Size: fi.Size()}
+			}
+			return nil
+		}
+		e.HasMeta = true
+		buf, err := ioutil.ReadFile(n)
+		if err != nil {
+			e.Err = err
+			return nil
+		}
+		var meta Meta
+		if err = json.Unmarshal(buf, &meta); err != nil {
+			e.Err = fmt.Errorf("invalid metadata: %v", err)
+			return nil
+		}
+		e.Meta = meta
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var entries []Entry
+	for _, e := range m {
+		entries = append(entries, *e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+	return entries, nil
+}
+
+// VerifyEntry verifies the entry's file in the cache directory against its
+// metadata.
+func VerifyEntry(dir string, e Entry) error {
+	switch {
+	case e.Err != nil:
+		return e.Err
+	case e.Missing:
+		return errors.New("file missing")
+	case !e.HasMeta:
+		return errors.New("no metadata")
+	}
+	buf, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(e.Name)))
+	if err != nil {
+		return err
+	}
+	return e.Meta.Check(buf)
+}
+
+// Prune removes the entries of the cache directory retrieved before the
+// time, along with any metadata files without a file, returning the removed
+// entries. Directories left empty are removed.
+func Prune(dir string, before time.Time) ([]Entry, error) {
+	dir = filepath.Clean(dir)
+	entries, err := Entries(dir)
+	if err != nil {
+		return nil, err
+	}
+	var removed []Entry
+	for _, e := range entries {
+		if !e.Missing && !e.Meta.Fetched.Before(before) {
+			continue
+		}
+		n := filepath.Join(dir, filepath.FromSlash(e.Name))
+		for _, z := range []string{n, n + MetaExt} {
+			if err := os.Remove(z); err != nil && !os.IsNotExist(err) {
+				return removed, err
+			}
+		}
+		removed = append(removed, e)
+		removeEmptyDirs(dir, filepath.Dir(n))
+	}
+	return removed, nil
+}
+
+// removeEmptyDirs removes the directory n and its parents, up to but not
+// including dir, while they are empty.
+func removeEmptyDirs(dir, n string) {
+	for n != dir && strings.HasPrefix(n, dir) {
+		if os.Remove(n) != nil {
+			return
+		}
+		n = filepath.Dir(n)
+	}
+}
+
+// Export writes the files and metadata files of the cache directory to w as
+// a gzipped tarball. Metadata files that cannot be read (see Entry) are not
+// written, as Import would reject them.
+func Export(dir string, w io.Writer) error {
+	entries, err := Entries(dir)
+	if err != nil {
+		return err
+	}
+	zw := gzip.NewWriter(w)
+	tw := tar.NewWriter(zw)
+	for _, e := range entries {
+		var names []string
+		if !e.Missing {
+			names = append(names, e.Name)
+		}
+		if e.HasMeta && e.Err == nil {
+			names = append(names, e.Name+MetaExt)
+		}
+		for _, name := range names {
+			buf, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+			if err != nil {
+				return err
+			}
+			if err = tw.WriteHeader(&tar.Header{
+				Name:    name,
+				Mode:    0644,
+				Size:    int64(len(buf)),
+				ModTime: e.Meta.Fetched,
+			}); err != nil {
+				return err
+			}
+			if _, err = tw.Write(buf); err != nil {
+				return err
+			}
+		}
+	}
+	if err = tw.Close(); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// Import reads a gzipped tarball written by Export from r, writing its files
+// and metadata files to the cache directory and returning the names of the
+// imported files. Every file having metadata is verified against it before
+// anything is written, and no files are written when any fails verification.
+func Import(dir string, r io.Reader) ([]string, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	tr := tar.NewReader(zr)
+	files, modTimes := make(map[string][]byte), make(map[string]time.Time)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if h.Typeflag == tar.TypeDir {
+			continue
+		}
+		name := path.Clean(h.Name)
+		if h.Typeflag != tar.TypeReg || path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return nil, fmt.Errorf("invalid tarball entry %q", h.Name)
+		}
+		if files[name], err = ioutil.ReadAll(tr); err != nil {
+			return nil, err
+		}
+		modTimes[name] = h.ModTime
+	}
+
+	// verify
+	var names []string
+	for name, buf := range files {
+		if strings.HasSuffix(name, MetaExt) {
+			continue
+		}
+		names = append(names, name)
+		metaBuf, ok := files[name+MetaExt]
+		if !ok {
+			continue
+		}
+		var meta Meta
+		if err := json.Unmarshal(metaBuf, &meta); err != nil {
+			return nil, fmt.Errorf("%s: invalid metadata: %v", name, err)
+		}
+		if err := meta.Check(buf); err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+	}
+	sort.Strings(names)
+
+	// write
+	for name, buf := range files {
+		n := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(n), 0755); err != nil {
+			return nil, err
+		}
+		if err := WriteFile(n, buf); err != nil {
+			return nil, err
+		}
+		if err := os.Chtimes(n, modTimes[name], modTimes[name]); err != nil {
+			return nil, err
+		}
+		// remove stale metadata
+		if _, ok := files[name+MetaExt]; !ok && !strings.HasSuffix(name, MetaExt) {
+			if err := os.Remove(n + MetaExt); err != nil && !os.IsNotExist(err) {
+				return nil, err
+			}
+		}
+	}
+	return names, nil
+}
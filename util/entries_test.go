@@ -0,0 +1,302 @@
+package util
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEntries(t *testing.T) {
+	dir := newTestCache(t)
+	defer os.RemoveAll(dir)
+
+	entries, err := Entries(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []struct {
+		name             string
+		hasMeta, missing bool
+		err              string
+	}{
+		{"bad.pdl", true, false, "invalid metadata: "},
+		{"pdl/a.pdl", true, false, ""},
+		{"pdl/changed.pdl", true, false, ""},
+		{"pdl/missing.pdl", true, true, ""},
+		{"pdl/nometa.pdl", false, false, ""},
+	}
+	if len(entries) != len(exp) {
+		t.Fatalf("expected %d entries, got: %v", len(exp), entries)
+	}
+	for i, e := range entries {
+		switch {
+		case e.Name != exp[i].name || e.HasMeta != exp[i].hasMeta || e.Missing != exp[i].missing:
+			t.Errorf("entry %d expected %s (meta: %t, missing: %t), got: %s (meta: %t, missing: %t)", i, exp[i].name, exp[i].hasMeta, exp[i].missing, e.Name, e.HasMeta, e.Missing)
+		case exp[i].err == "" && e.Err != nil:
+			t.Errorf("entry %d expected no error, got: %v", i, e.Err)
+		case exp[i].err != "" && (e.Err == nil || !strings.HasPrefix(e.Err.Error(), exp[i].err)):
+			t.Errorf("entry %d expected error %q, got: %v", i, exp[i].err, e.Err)
+		}
+	}
+
+	// metadata
+	if e := entries[1]; e.Meta.URL != "https://example.com/a.pdl" || e.Meta.Size != 1 {
+		t.Errorf("expected a.pdl metadata, got: %#v", e.Meta)
+	}
+	for _, i := range []int{0, 4} {
+		if e := entries[i]; e.Meta.Size != 1 || e.Meta.Fetched.IsZero() {
+			t.Errorf("expected %s size and modification time, got: %#v", e.Name, e.Meta)
+		}
+	}
+
+	// missing directory
+	entries, err = Entries(filepath.Join(dir, "missing"))
+	if err != nil || len(entries) != 0 {
+		t.Errorf("expected no entries and no error, got: %v %v", entries, err)
+	}
+}
+
+func TestVerifyEntry(t *testing.T) {
+	dir := newTestCache(t)
+	defer os.RemoveAll(dir)
+
+	entries, err := Entries(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := map[string]string{
+		"bad.pdl":         "invalid metadata: ",
+		"pdl/a.pdl":       "",
+		"pdl/changed.pdl": "size 3 does not match recorded size 1",
+		"pdl/missing.pdl": "file missing",
+		"pdl/nometa.pdl":  "no metadata",
+	}
+	for _, e := range entries {
+		err := VerifyEntry(dir, e)
+		switch s := exp[e.Name]; {
+		case s == "" && err != nil:
+			t.Errorf("%s expected no error, got: %v", e.Name, err)
+		case s != "" && (err == nil || !strings.HasPrefix(err.Error(), s)):
+			t.Errorf("%s expected error %q, got: %v", e.Name, s, err)
+		}
+	}
+}
+
+func TestPrune(t *testing.T) {
+	dir := newTestCache(t)
+	defer os.RemoveAll(dir)
+	old := time.Now().Add(-48 * time.Hour)
+	if err := os.MkdirAll(filepath.Join(dir, "old", "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteEntry(filepath.Join(dir, "old", "sub", "b.pdl"), []byte("b"), Meta{Fetched: old}); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(filepath.Join(dir, "pdl", "nometa.pdl"), old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err := Prune(dir, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, e := range removed {
+		names = append(names, e.Name)
+	}
+	if s, exp := strings.Join(names, ","), "old/sub/b.pdl,pdl/missing.pdl,pdl/nometa.pdl"; s != exp {
+		t.Errorf("expected removed %s, got: %s", exp, s)
+	}
+	for _, name := range []string{"old", "pdl/missing.pdl.meta", "pdl/nometa.pdl"} {
+		if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(name))); !os.IsNotExist(err) {
+			t.Errorf("expected %s to be removed, got: %v", name, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "pdl", "a.pdl")); err != nil {
+		t.Errorf("expected pdl/a.pdl to be kept, got: %v", err)
+	}
+
+	// missing directory
+	if removed, err = Prune(filepath.Join(dir, "missing"), time.Now()); err != nil || len(removed) != 0 {
+		t.Errorf("expected nothing removed and no error, got: %v %v", removed, err)
+	}
+}
+
+func TestExportImport(t *testing.T) {
+	dir := newTestCache(t)
+	defer os.RemoveAll(dir)
+	if err := os.Remove(filepath.Join(dir, "pdl", "changed.pdl")); err != nil {
+		t.Fatal(err)
+	}
+
+	// export
+	buf := new(bytes.Buffer)
+	if err := Export(dir, buf); err != nil {
+		t.Fatal(err)
+	}
+	if s, exp := strings.Join(tarNames(t, buf.Bytes()), ","), "bad.pdl,pdl/a.pdl,pdl/a.pdl.meta,pdl/changed.pdl.meta,pdl/missing.pdl.meta,pdl/nometa.pdl"; s != exp {
+		t.Errorf("expected tarball files %s, got: %s", exp, s)
+	}
+
+	// import
+	out := tempDir(t)
+	defer os.RemoveAll(out)
+	if err := ioutil.WriteFile(filepath.Join(out, "bad.pdl"+MetaExt), []byte("stale"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	names, err := Import(out, bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, exp := strings.Join(names, ","), "bad.pdl,pdl/a.pdl,pdl/nometa.pdl"; s != exp {
+		t.Errorf("expected imported %s, got: %s", exp, s)
+	}
+	entries, err := Entries(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		if e.Err != nil {
+			t.Errorf("expected stale metadata of %s to be removed, got: %v", e.Name, e.Err)
+		}
+		if e.Name == "pdl/a.pdl" {
+			if err := VerifyEntry(out, e); err != nil {
+				t.Errorf("expected %s to verify, got: %v", e.Name, err)
+			}
+		}
+	}
+	if _, err := os.Stat(filepath.Join(out, "pdl", "missing.pdl")); !os.IsNotExist(err) {
+		t.Errorf("expected missing file to not be imported, got: %v", err)
+	}
+
+	// missing directory
+	if err := Export(filepath.Join(dir, "missing"), new(bytes.Buffer)); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+}
+
+func TestImportInvalid(t *testing.T) {
+	meta := func(s string) string {
+		return fmt.Sprintf(`{"fetched":"2024-01-02T03:04:05Z","sha256":%q,"size":%d}`, sha256Hex([]byte(s)), len(s))
+	}
+	tests := []struct {
+		name  string
+		files []tarFile
+		err   string
+	}{
+		{"parent", []tarFile{{"../x.pdl", "x", tar.TypeReg}}, `invalid tarball entry "../x.pdl"`},
+		{"nested parent", []tarFile{{"a/../../x.pdl", "x", tar.TypeReg}}, `invalid tarball entry "a/../../x.pdl"`},
+		{"absolute", []tarFile{{"/tmp/x.pdl", "x", tar.TypeReg}}, `invalid tarball entry "/tmp/x.pdl"`},
+		{"symlink", []tarFile{{"x.pdl", "", tar.TypeSymlink}}, `invalid tarball entry "x.pdl"`},
+		{"invalid metadata", []tarFile{{"a.pdl", "a", tar.TypeReg}, {"a.pdl.meta", "{", tar.TypeReg}}, "a.pdl: invalid metadata: "},
+		{"checksum", []tarFile{{"a.pdl", "b", tar.TypeReg}, {"a.pdl.meta", meta("a"), tar.TypeReg}}, "a.pdl: sha256 "},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := tempDir(t)
+			defer os.RemoveAll(dir)
+			out := filepath.Join(dir, "out")
+			// valid files before the invalid one are not written either
+			files := append([]tarFile{{"ok.pdl", "ok", tar.TypeReg}, {"ok.pdl.meta", meta("ok"), tar.TypeReg}}, test.files...)
+			_, err := Import(out, bytes.NewReader(newTarball(t, files)))
+			if err == nil || !strings.HasPrefix(err.Error(), test.err) {
+				t.Fatalf("expected error %q, got: %v", test.err, err)
+			}
+			for _, name := range []string{"out", "x.pdl"} {
+				if _, err := os.Stat(filepath.Join(dir, name)); !os.IsNotExist(err) {
+					t.Errorf("expected %s to not be written, got: %v", name, err)
+				}
+			}
+		})
+	}
+}
+
+// newTestCache creates a cache directory containing a file with metadata, a
+// file changed since written, a file without metadata, metadata without a
+// file, a file with invalid metadata, and a temporary file.
+func newTestCache(t *testing.T) string {
+	t.Helper()
+	dir := tempDir(t)
+	if err := os.MkdirAll(filepath.Join(dir, "pdl"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"a.pdl", "changed.pdl", "missing.pdl"} {
+		if err := WriteEntry(filepath.Join(dir, "pdl", name), []byte("a"), Meta{URL: "https://example.com/" + name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Remove(filepath.Join(dir, "pdl", "missing.pdl")); err != nil {
+		t.Fatal(err)
+	}
+	for name, s := range map[string]string{
+		"pdl/changed.pdl":  "abc",
+		"pdl/nometa.pdl":   "a",
+		"pdl/.a.pdl.12345": "a",
+		"bad.pdl":          "a",
+		"bad.pdl.meta":     "{",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(dir, filepath.FromSlash(name)), []byte(s), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// tarFile is a file in a test tarball.
+type tarFile struct {
+	name, body string
+	typ        byte
+}
+
+// newTarball creates a gzipped tarball of the files.
+func newTarball(t *testing.T, files []tarFile) []byte {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	zw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(zw)
+	for _, f := range files {
+		h := &tar.Header{Name: f.name, Mode: 0644, Size: int64(len(f.body)), Typeflag: f.typ}
+		if f.typ == tar.TypeSymlink {
+			h.Linkname, h.Size = "/etc/passwd", 0
+		}
+		if err := tw.WriteHeader(h); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(f.body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// tarNames returns the names of the files in a gzipped tarball.
+func tarNames(t *testing.T, buf []byte) []string {
+	t.Helper()
+	zr, err := gzip.NewReader(bytes.NewReader(buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(zr)
+	var names []string
+	for {
+		h, err := tr.Next()
+		if err != nil {
+			break
+		}
+		names = append(names, h.Name)
+	}
+	return names
+}
@@ -0,0 +1,224 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ChromiumDashURL is the Chromium Dash release API URL.
+const ChromiumDashURL = "https://chromiumdash.appspot.com/fetch_releases"
+
+// ReleaseInfo is the interface for determining the current versions of the
+// Chrome release channels.
+type ReleaseInfo interface {
+	// ChannelVersion returns the current version of the release channel (ie,
+	// stable, beta, dev, canary, or extended).
+	ChannelVersion(ctx context.Context, channel string) (string, error)
+
+	// ChannelVersions returns the versions released on the release channel.
+	ChannelVersions(ctx context.Context, channel string) ([]string, error)
+}
+
+// NewReleaseInfo creates the release info provider for loc: either
+// "chromiumdash" to retrieve the channel versions from Chromium Dash (caching
+// the responses in the cache directory), or the path to a local JSON file
+// (see ReleaseFile).
+func NewReleaseInfo(loc, cache string, ttl time.Duration, client *http.Client) ReleaseInfo {
+	if loc == "" || loc == "chromiumdash" {
+		return ChromiumDash{
+			URL:      ChromiumDashURL,
+			Platform: "Linux",
+			Cache:    cache,
+			TTL:      ttl,
+			Client:   client,
+		}
+	}
+	return ReleaseFile(loc)
+}
+
+// ChromiumDash is a release info provider retrieving the channel versions
+// from the Chromium Dash release API, caching them locally.
+type ChromiumDash struct {
+	// URL is the release API URL.
+	URL string
+
+	// Platform is the platform of the releases (ie, Linux).
+	Platform string
+
+	// Cache is the cache directory.
+	Cache string
+
+	// TTL is the cache TTL.
+	TTL time.Duration
+
+	// Client is the HTTP client used to retrieve releases.
+	Client *http.Client
+}
+
+// ChannelVersion satisfies the ReleaseInfo interface.
+func (d ChromiumDash) ChannelVersion(ctx context.Context, channel string) (string, error) {
+	buf, err := d.releases(ctx, channel, 1, channel)
+	if err != nil {
+		return "", err
+	}
+	return releaseVersion(buf, channel)
+}
+
+// ChannelVersions satisfies the ReleaseInfo interface.
+func (d ChromiumDash) ChannelVersions(ctx context.Context, channel string) ([]string, error) {
+	buf, err := d.releases(ctx, channel, 1000, channel+"-history")
+	if err != nil {
+		return nil, err
+	}
+	return releaseVersions(buf, channel)
+}
+
+// releases retrieves the num most recent releases of the channel, caching
+// them as name.
+func (d ChromiumDash) releases(ctx context.Context, channel string, num int, name string) ([]byte, error) {
+	if !isChannel(channel) {
+		return nil, fmt.Errorf("invalid release channel %q", channel)
+	}
+	q := url.Values{
+		"channel":  []string{capitalize(channel)},
+		"platform": []string{d.Platform},
+		"num":      []string{strconv.Itoa(num)},
+	}
+	return Get(ctx, Cache{
+		URL:    d.URL + "?" + q.Encode(),
+		Path:   filepath.Join(d.Cache, "releases", strings.ToLower(d.Platform)+"-"+name+".json"),
+		TTL:    d.TTL,
+		Client: d.Client,
+	})
+}
+
+// capitalize returns s with its first rune in upper case (ie, Stable).
+func capitalize(s string) string {
+	r, n := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[n:]
+}
+
+// ReleaseFile is a release info provider reading the channel versions from a
+// local JSON file, either as an object mapping channel names to versions:
+//
+//	{"stable": "120.0.6099.109", "beta": "121.0.6167.16"}
+//
+// or as an array of releases, in the same format as the Chromium Dash release
+// API:
+//
+//	[{"channel": "Stable", "version": "120.0.6099.109"}]
+//
+// When a channel has more than one release in an array, the highest version
+// is the channel's current version.
+type ReleaseFile string
+
+// ChannelVersion satisfies the ReleaseInfo interface.
+func (f ReleaseFile) ChannelVersion(ctx context.Context, channel string) (string, error) {
+	buf, err := ioutil.ReadFile(string(f))
+	if err != nil {
+		return "", err
+	}
+	ver, err := releaseVersion(buf, channel)
+	if err != nil {
+		return "", fmt.Errorf("%s: %v", f, err)
+	}
+	return ver, nil
+}
+
+// ChannelVersions satisfies the ReleaseInfo interface.
+func (f ReleaseFile) ChannelVersions(ctx context.Context, channel string) ([]string, error) {
+	buf, err := ioutil.ReadFile(string(f))
+	if err != nil {
+		return nil, err
+	}
+	versions, err := releaseVersions(buf, channel)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", f, err)
+	}
+	return versions, nil
+}
+
+// releaseVersion returns the highest version of the channel from the JSON
+// encoded releases in buf.
+func releaseVersion(buf []byte, channel string) (string, error) {
+	versions, err := releaseVersions(buf, channel)
+	if err != nil {
+		return "", err
+	}
+	return versions[len(versions)-1], nil
+}
+
+// releaseVersions returns the versions of the channel from the JSON encoded
+// releases in buf, sorted.
+func releaseVersions(buf []byte, channel string) ([]string, error) {
+	var versions []string
+
+	// object
+	var m map[string]string
+	if err := json.Unmarshal(buf, &m); err == nil {
+		for k, v := range m {
+			if strings.EqualFold(k, channel) {
+				versions = append(versions, v)
+			}
+		}
+	} else {
+		// array
+		var releases []struct {
+			Channel string `json:"channel"`
+			Version string `json:"version"`
+		}
+		if err := json.Unmarshal(buf, &releases); err != nil {
+			return nil, fmt.Errorf("invalid release info: %v", err)
+		}
+		for _, r := range releases {
+			if strings.EqualFold(r.Channel, channel) {
+				versions = append(versions, r.Version)
+			}
+		}
+	}
+
+	v := SortVersions(versions)
+	if len(v) == 0 {
+		return nil, fmt.Errorf("no %s release version", channel)
+	}
+	versions = versions[:0]
+	for i, z := range v {
+		if i == 0 || z.Compare(v[i-1]) != 0 {
+			versions = append(versions, z.String())
+		}
+	}
+	return versions, nil
+}
+
+// ResolveVersion resolves the version specifier (see VersionSpec) to a
+// release version, selecting from the release versions of src, or using info
+// to determine the version of a release channel.
+func ResolveVersion(ctx context.Context, src Source, info ReleaseInfo, spec string) (string, error) {
+	vs, err := ParseVersionSpec(spec)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case vs.Exact != "":
+		return vs.Exact, nil
+	case vs.Channel != "" && info == nil:
+		return "", fmt.Errorf("release channel %q is not supported", vs.Channel)
+	case vs.Channel != "":
+		return info.ChannelVersion(ctx, vs.Channel)
+	}
+	versions, err := src.Versions(ctx)
+	if err != nil {
+		return "", err
+	}
+	return vs.Select(versions)
+}
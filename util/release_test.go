@@ -0,0 +1,190 @@
+package util
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// releasesObject is release info as an object mapping channel names to
+// versions.
+const releasesObject = `{"stable": "120.0.6099.109", "Beta": "121.0.6167.16", "dev": "v1.0"}`
+
+// releasesArray is release info in the format of the Chromium Dash release
+// API.
+const releasesArray = `[
+  {"channel": "Stable", "version": "120.0.6099.109"},
+  {"channel": "Stable", "version": "120.0.6099.71"},
+  {"channel": "Beta", "version": "121.0.6167.16"},
+  {"channel": "Stable", "version": "120.0.6099.109"},
+  {"channel": "Stable", "version": "119.0.6045.199"}
+]`
+
+func TestReleaseVersions(t *testing.T) {
+	tests := []struct {
+		name, buf, channel string
+		exp                string
+		err                string
+	}{
+		{"object", releasesObject, "stable", "120.0.6099.109", ""},
+		{"object case", releasesObject, "beta", "121.0.6167.16", ""},
+		{"object invalid version", releasesObject, "dev", "", "no dev release version"},
+		{"object missing", releasesObject, "canary", "", "no canary release version"},
+		{"array", releasesArray, "stable", "119.0.6045.199 120.0.6099.71 120.0.6099.109", ""},
+		{"array single", releasesArray, "beta", "121.0.6167.16", ""},
+		{"array missing", releasesArray, "extended", "", "no extended release version"},
+		{"empty array", `[]`, "stable", "", "no stable release version"},
+		{"invalid", `{"stable":`, "stable", "", "invalid release info: "},
+		{"invalid type", `"stable"`, "stable", "", "invalid release info: "},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			versions, err := releaseVersions([]byte(test.buf), test.channel)
+			switch {
+			case test.err != "" && (err == nil || !strings.HasPrefix(err.Error(), test.err)):
+				t.Fatalf("expected error %q, got: %v", test.err, err)
+			case test.err != "":
+				return
+			case err != nil:
+				t.Fatal(err)
+			}
+			if s := strings.Join(versions, " "); s != test.exp {
+				t.Errorf("expected %s, got: %s", test.exp, s)
+			}
+			ver, err := releaseVersion([]byte(test.buf), test.channel)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if exp := versions[len(versions)-1]; ver != exp {
+				t.Errorf("expected %s, got: %s", exp, ver)
+			}
+		})
+	}
+}
+
+func TestReleaseFile(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "releases.json")
+	if err := ioutil.WriteFile(name, []byte(releasesArray), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	f := NewReleaseInfo(name, "", 0, nil)
+	ver, err := f.ChannelVersion(context.Background(), "stable")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := "120.0.6099.109"; ver != exp {
+		t.Errorf("expected %s, got: %s", exp, ver)
+	}
+	versions, err := f.ChannelVersions(context.Background(), "stable")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, exp := strings.Join(versions, " "), "119.0.6045.199 120.0.6099.71 120.0.6099.109"; s != exp {
+		t.Errorf("expected %s, got: %s", exp, s)
+	}
+
+	// errors are prefixed with the file name
+	if _, err := f.ChannelVersion(context.Background(), "dev"); err == nil || err.Error() != name+": no dev release version" {
+		t.Errorf("expected no dev release version error, got: %v", err)
+	}
+	if _, err := ReleaseFile(filepath.Join(dir, "missing.json")).ChannelVersion(context.Background(), "stable"); !os.IsNotExist(err) {
+		t.Errorf("expected not exist error, got: %v", err)
+	}
+}
+
+func TestChromiumDash(t *testing.T) {
+	defer func(logf func(string, ...interface{}), retries int) { Logf, Retries = logf, retries }(Logf, Retries)
+	Logf, Retries = t.Logf, 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("channel") != "Stable" || q.Get("platform") != "Linux" {
+			http.Error(w, "invalid query "+r.URL.RawQuery, http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(releasesArray))
+	}))
+	defer s.Close()
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	d := NewReleaseInfo("chromiumdash", dir, 0, nil).(ChromiumDash)
+	d.URL = s.URL
+	ver, err := d.ChannelVersion(context.Background(), "stable")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := "120.0.6099.109"; ver != exp {
+		t.Errorf("expected %s, got: %s", exp, ver)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "releases", "linux-stable.json")); err != nil {
+		t.Errorf("expected releases to be cached, got: %v", err)
+	}
+	if _, err := d.ChannelVersion(context.Background(), "nightly"); err == nil || err.Error() != `invalid release channel "nightly"` {
+		t.Errorf("expected invalid release channel error, got: %v", err)
+	}
+}
+
+func TestResolveVersion(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	var tags []string
+	for _, v := range testVersions {
+		tags = append(tags, testRev+" refs/tags/"+v)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "tags"), []byte(strings.Join(tags, "\n")), 0644); err != nil {
+		t.Fatal(err)
+	}
+	name := filepath.Join(dir, "releases.json")
+	if err := ioutil.WriteFile(name, []byte(releasesObject), 0644); err != nil {
+		t.Fatal(err)
+	}
+	src := Prefetched(dir)
+
+	tests := []struct {
+		spec string
+		info ReleaseInfo
+		exp  string
+		err  string
+	}{
+		{"", nil, "121.0.6167.16", ""},
+		{"latest-patch-of:118", nil, "118.0.5993.117", ""},
+		{">=119 <121", nil, "120.0.6099.109", ""},
+		{"120.0.6099.1", nil, "120.0.6099.1", ""},
+		{testRev, nil, testRev, ""},
+		{"stable", ReleaseFile(name), "120.0.6099.109", ""},
+		{"Beta", ReleaseFile(name), "121.0.6167.16", ""},
+		{"stable", nil, "", `release channel "stable" is not supported`},
+		{"canary", ReleaseFile(name), "", name + ": no canary release version"},
+		{">=122", nil, "", `could not find a version tag matching ">=122"`},
+		{"foo", nil, "", `invalid version specifier "foo"`},
+	}
+	for _, test := range tests {
+		t.Run(test.spec, func(t *testing.T) {
+			ver, err := ResolveVersion(context.Background(), src, test.info, test.spec)
+			switch {
+			case test.err != "" && (err == nil || err.Error() != test.err):
+				t.Fatalf("expected error %q, got: %v", test.err, err)
+			case test.err == "" && err != nil:
+				t.Fatal(err)
+			}
+			if ver != test.exp {
+				t.Errorf("expected %q, got: %q", test.exp, ver)
+			}
+		})
+	}
+
+	// sources are not read for exact versions and channels
+	for _, spec := range []string{"120.0.6099.1", "stable"} {
+		if _, err := ResolveVersion(context.Background(), Prefetched(filepath.Join(dir, "missing")), ReleaseFile(name), spec); err != nil {
+			t.Errorf("%s expected no error, got: %v", spec, err)
+		}
+	}
+}
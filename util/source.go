@@ -0,0 +1,465 @@
+package util
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Source is the interface for retrieving the files of a source repository
+// (ie, the Chromium or V8 source tree).
+type Source interface {
+	// Versions returns the release versions (ie, tags) of the repository.
+	Versions(ctx context.Context) ([]string, error)
+
+	// Tag returns the release version tagged at the revision, returning a
+	// *NoTagError when no release version is tagged at the revision.
+	Tag(ctx context.Context, rev string) (string, error)
+
+	// ReadFile reads the named file of the repository at the ref. Names are
+	// slash-separated paths, relative to the repository's root.
+	ReadFile(ctx context.Context, ref, name string) ([]byte, error)
+}
+
+// SourceKinds are the source kinds supported by NewSource.
+var SourceKinds = []string{"gitiles", "checkout", "git", "http", "dir"}
+
+// NewSource creates a source of the kind for the repository at loc.
+//
+// For gitiles and http sources, loc is the repository's base URL, and
+// retrieved files are cached in the cache directory. For checkout, git, and
+// dir sources, loc is the path to the local checkout, git repository, or
+// directory of pre-fetched files. The name is the repository's name (ie,
+// chromium or v8), used for the cache paths. The client is used to retrieve
+// files for gitiles and http sources, and when nil, DefaultClient is used.
+func NewSource(kind, name, loc, cache string, ttl time.Duration, client *http.Client) (Source, error) {
+	switch kind {
+	case "gitiles":
+		return Gitiles{Name: name, URL: loc, Cache: cache, TTL: ttl, Client: client}, nil
+	case "checkout":
+		return Checkout(loc), nil
+	case "git":
+		return GitRepo(loc), nil
+	case "http":
+		return Mirror{Name: name, URL: loc, Cache: cache, TTL: ttl, Client: client}, nil
+	case "dir":
+		return Prefetched(loc), nil
+	}
+	return nil, fmt.Errorf("invalid source %q", kind)
+}
+
+// DepVersion returns the release version of the typ dependency (ie, v8) used
+// by version ver of the Chromium source tree, as specified in its DEPS file.
+//
+// When no release version is tagged at the dependency's revision, the
+// revision itself is returned, as files can be read from sources at any
+// revision.
+func DepVersion(ctx context.Context, chromium, dep Source, typ, ver string) (string, error) {
+	buf, err := chromium.ReadFile(ctx, ver, "DEPS")
+	if err != nil {
+		return "", err
+	}
+	rev, err := DepRevision(typ, buf)
+	if err != nil {
+		return "", fmt.Errorf("%s version %s: %v", typ, ver, err)
+	}
+	tag, err := dep.Tag(ctx, rev)
+	if _, ok := err.(*NoTagError); ok {
+		Logf("UNTAGGED: %s revision %s (chromium %s), using revision", typ, rev, ver)
+		return rev, nil
+	}
+	return tag, err
+}
+
+// NoTagError is the error returned when no release version is tagged at a
+// revision.
+type NoTagError struct {
+	Name string
+	Rev  string
+}
+
+// Error satisfies the error interface.
+func (err *NoTagError) Error() string {
+	return fmt.Sprintf("could not find %s revision tag for rev %s", err.Name, err.Rev)
+}
+
+// SourceDir is a directory of a source at a ref.
+//
+// Satisfies the pdl.FileSystem interface, allowing included PDL files to be
+// retrieved from the source.
+type SourceDir struct {
+	Source Source
+	Ref    string
+	Dir    string
+
+	// Context is the context used when reading files. When nil,
+	// context.Background() is used.
+	Context context.Context
+}
+
+// ReadFile reads the named file relative to the directory.
+func (d SourceDir) ReadFile(name string) ([]byte, error) {
+	ctx := d.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return d.Source.ReadFile(ctx, d.Ref, path.Join(d.Dir, name))
+}
+
+// Gitiles is a source retrieving files from a gitiles server (ie,
+// chromium.googlesource.com), caching them locally.
+type Gitiles struct {
+	// Name is the repository name.
+	Name string
+
+	// URL is the repository's base URL.
+	URL string
+
+	// Cache is the cache directory.
+	Cache string
+
+	// TTL is the cache TTL.
+	TTL time.Duration
+
+	// Client is the HTTP client used to retrieve files.
+	Client *http.Client
+}
+
+// Versions satisfies the Source interface.
+func (g Gitiles) Versions(ctx context.Context) ([]string, error) {
+	buf, err := Get(ctx, Cache{
+		URL:    g.URL,
+		Path:   filepath.Join(g.Cache, "html", g.Name+".html"),
+		TTL:    g.TTL,
+		Client: g.Client,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tagsFromHTML(buf)
+}
+
+// Tag satisfies the Source interface.
+func (g Gitiles) Tag(ctx context.Context, rev string) (string, error) {
+	refs, err := GetRefs(ctx, Cache{
+		URL:    g.URL + "/+refs?format=JSON",
+		Path:   filepath.Join(g.Cache, "refs", g.Name+".json"),
+		TTL:    g.TTL,
+		Client: g.Client,
+	})
+	if err != nil {
+		return "", err
+	}
+	tags := make(map[string]string)
+	for k, v := range refs {
+		if strings.HasPrefix(k, "refs/tags/") {
+			tags[strings.TrimPrefix(k, "refs/tags/")] = v.Value
+		}
+	}
+	return findTag(g.Name, tags, rev)
+}
+
+// ReadFile satisfies the Source interface.
+func (g Gitiles) ReadFile(ctx context.Context, ref, name string) ([]byte, error) {
+	return Get(ctx, Cache{
+		URL:    g.URL + "/+/" + ref + "/" + name + "?format=TEXT",
+		Path:   filepath.Join(g.Cache, "src", g.Name, ref, filepath.FromSlash(name)),
+		TTL:    g.TTL,
+		Decode: true,
+		Client: g.Client,
+	})
+}
+
+// Mirror is a source retrieving files from a plain HTTP mirror of a directory
+// of pre-fetched files (see Prefetched), caching them locally.
+type Mirror struct {
+	// Name is the repository name.
+	Name string
+
+	// URL is the mirror's base URL.
+	URL string
+
+	// Cache is the cache directory.
+	Cache string
+
+	// TTL is the cache TTL.
+	TTL time.Duration
+
+	// Client is the HTTP client used to retrieve files.
+	Client *http.Client
+}
+
+// tags retrieves the mirror's tags file.
+func (m Mirror) tags(ctx context.Context) (map[string]string, error) {
+	buf, err := Get(ctx, Cache{
+		URL:    strings.TrimSuffix(m.URL, "/") + "/tags",
+		Path:   filepath.Join(m.Cache, "refs", m.Name+".tags"),
+		TTL:    m.TTL,
+		Client: m.Client,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseTags(buf)
+}
+
+// Versions satisfies the Source interface.
+func (m Mirror) Versions(ctx context.Context) ([]string, error) {
+	tags, err := m.tags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tagNames(tags), nil
+}
+
+// Tag satisfies the Source interface.
+func (m Mirror) Tag(ctx context.Context, rev string) (string, error) {
+	tags, err := m.tags(ctx)
+	if err != nil {
+		return "", err
+	}
+	return findTag(m.Name, tags, rev)
+}
+
+// ReadFile satisfies the Source interface.
+func (m Mirror) ReadFile(ctx context.Context, ref, name string) ([]byte, error) {
+	return Get(ctx, Cache{
+		URL:    strings.TrimSuffix(m.URL, "/") + "/" + ref + "/" + name,
+		Path:   filepath.Join(m.Cache, "src", m.Name, ref, filepath.FromSlash(name)),
+		TTL:    m.TTL,
+		Client: m.Client,
+	})
+}
+
+// Prefetched is a source reading files from a local directory of pre-fetched
+// files, laid out as '<ref>/<name>', along with a 'tags' file listing the
+// revision and name of each tag, one per line, in the same format as 'git
+// show-ref --tags'.
+type Prefetched string
+
+// Versions satisfies the Source interface.
+func (p Prefetched) Versions(ctx context.Context) ([]string, error) {
+	tags, err := p.tags()
+	if err != nil {
+		return nil, err
+	}
+	return tagNames(tags), nil
+}
+
+// Tag satisfies the Source interface.
+func (p Prefetched) Tag(ctx context.Context, rev string) (string, error) {
+	tags, err := p.tags()
+	if err != nil {
+		return "", err
+	}
+	return findTag(filepath.Base(string(p)), tags, rev)
+}
+
+// ReadFile satisfies the Source interface.
+func (p Prefetched) ReadFile(ctx context.Context, ref, name string) ([]byte, error) {
+	return ioutil.ReadFile(filepath.Join(string(p), ref, filepath.FromSlash(name)))
+}
+
+// tags reads the tags file.
+func (p Prefetched) tags() (map[string]string, error) {
+	buf, err := ioutil.ReadFile(filepath.Join(string(p), "tags"))
+	if err != nil {
+		return nil, err
+	}
+	return parseTags(buf)
+}
+
+// GitRepo is a source reading files from a local git repository (which can
+// be bare), using the git command.
+type GitRepo string
+
+// git runs the git command with args in the repository.
+func (r GitRepo) git(ctx context.Context, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", string(r)}, args...)...)
+	buf, err := cmd.Output()
+	if e, ok := err.(*exec.ExitError); ok && len(e.Stderr) != 0 {
+		return nil, fmt.Errorf("git %s: %s", args[0], bytes.TrimSpace(e.Stderr))
+	}
+	return buf, err
+}
+
+// Versions satisfies the Source interface.
+func (r GitRepo) Versions(ctx context.Context) ([]string, error) {
+	buf, err := r.git(ctx, "tag", "--list")
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(string(buf)), nil
+}
+
+// Tag satisfies the Source interface.
+func (r GitRepo) Tag(ctx context.Context, rev string) (string, error) {
+	if err := checkRev("revision", rev); err != nil {
+		return "", err
+	}
+	buf, err := r.git(ctx, "tag", "--points-at", rev)
+	if err != nil {
+		return "", err
+	}
+	for _, tag := range strings.Fields(string(buf)) {
+		if VerRE.MatchString(tag) {
+			return tag, nil
+		}
+	}
+	return "", &NoTagError{filepath.Base(string(r)), rev}
+}
+
+// ReadFile satisfies the Source interface.
+func (r GitRepo) ReadFile(ctx context.Context, ref, name string) ([]byte, error) {
+	if err := checkRev("ref", ref); err != nil {
+		return nil, err
+	}
+	return r.git(ctx, "show", ref+":"+name)
+}
+
+// checkRev checks that the ref or revision passed to the git command cannot
+// be interpreted as an option.
+func checkRev(kind, rev string) error {
+	if rev == "" || strings.HasPrefix(rev, "-") {
+		return fmt.Errorf("invalid %s %q", kind, rev)
+	}
+	return nil
+}
+
+// Checkout is a source reading files from a local checkout of the Chromium
+// or V8 source tree. Only the checkout's version (as determined from its
+// chrome/VERSION or include/v8-version.h files) is available.
+type Checkout string
+
+// Version returns the version of the checkout.
+func (c Checkout) Version() (string, error) {
+	// chromium
+	if buf, err := ioutil.ReadFile(filepath.Join(string(c), "chrome", "VERSION")); err == nil {
+		return parseVersionFile(buf, `(?m)^%s=(\d+)$`, "MAJOR", "MINOR", "BUILD", "PATCH")
+	}
+
+	// v8
+	buf, err := ioutil.ReadFile(filepath.Join(string(c), "include", "v8-version.h"))
+	if err != nil {
+		return "", fmt.Errorf("%s is not a chromium or v8 checkout", c)
+	}
+	ver, err := parseVersionFile(buf, `(?m)^#define\s+V8_%s\s+(\d+)`, "MAJOR_VERSION", "MINOR_VERSION", "BUILD_NUMBER", "PATCH_LEVEL")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(ver, ".0"), nil
+}
+
+// Versions satisfies the Source interface.
+func (c Checkout) Versions(ctx context.Context) ([]string, error) {
+	ver, err := c.Version()
+	if err != nil {
+		return nil, err
+	}
+	return []string{ver}, nil
+}
+
+// Tag satisfies the Source interface. Returns the checkout's version when rev
+// is the checkout's version, or for a git checkout, the revision of its HEAD.
+// Returns a *NoTagError for any other revision of a git checkout, and an error
+// when the checkout is not a git checkout, as its revision is not known.
+func (c Checkout) Tag(ctx context.Context, rev string) (string, error) {
+	ver, err := c.Version()
+	if err != nil {
+		return "", err
+	}
+	if rev == ver {
+		return ver, nil
+	}
+	if _, err := os.Stat(filepath.Join(string(c), ".git")); err != nil {
+		return "", fmt.Errorf("checkout %s is not a git checkout: cannot determine version for revision %s", c, rev)
+	}
+	buf, err := GitRepo(c).git(ctx, "rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	if head := strings.TrimSpace(string(buf)); !strings.EqualFold(rev, head) {
+		return "", &NoTagError{filepath.Base(string(c)), rev}
+	}
+	return ver, nil
+}
+
+// ReadFile satisfies the Source interface. Returns an error when ref is not
+// the checkout's version.
+func (c Checkout) ReadFile(ctx context.Context, ref, name string) ([]byte, error) {
+	ver, err := c.Version()
+	if err != nil {
+		return nil, err
+	}
+	if ref != ver {
+		return nil, fmt.Errorf("checkout %s is version %s, not %s", c, ver, ref)
+	}
+	return ioutil.ReadFile(filepath.Join(string(c), filepath.FromSlash(name)))
+}
+
+// parseVersionFile parses the version components from buf, using the format
+// string for the regular expression matching each component.
+func parseVersionFile(buf []byte, format string, names ...string) (string, error) {
+	v := make([]string, len(names))
+	for i, name := range names {
+		m := regexp.MustCompile(fmt.Sprintf(format, name)).FindSubmatch(buf)
+		if m == nil {
+			return "", fmt.Errorf("could not find %s in version file", name)
+		}
+		v[i] = string(m[1])
+	}
+	return strings.Join(v, "."), nil
+}
+
+// parseTags parses a list of tags in the same format as 'git show-ref
+// --tags', returning a map of the tag names to their revision. Peeled tags
+// (ie, 'refs/tags/<name>^{}') override the revision of the tag object.
+func parseTags(buf []byte) (map[string]string, error) {
+	tags := make(map[string]string)
+	s := bufio.NewScanner(bytes.NewReader(buf))
+	for s.Scan() {
+		f := strings.Fields(s.Text())
+		if len(f) == 0 {
+			continue
+		}
+		if len(f) != 2 || !strings.HasPrefix(f[1], "refs/tags/") {
+			return nil, fmt.Errorf("invalid tag line %q", s.Text())
+		}
+		tags[strings.TrimSuffix(strings.TrimPrefix(f[1], "refs/tags/"), "^{}")] = f[0]
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// tagNames returns the names of the tags, sorted.
+func tagNames(tags map[string]string) []string {
+	var v []string
+	for k := range tags {
+		v = append(v, k)
+	}
+	sort.Strings(v)
+	return v
+}
+
+// findTag finds the release version tag for revision rev in tags.
+func findTag(name string, tags map[string]string, rev string) (string, error) {
+	for _, k := range tagNames(tags) {
+		if tags[k] == rev && VerRE.MatchString(k) {
+			return k, nil
+		}
+	}
+	return "", &NoTagError{name, rev}
+}
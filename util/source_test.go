@@ -0,0 +1,129 @@
+package util
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// v8VersionH is a minimal include/v8-version.h.
+const v8VersionH = `#define V8_MAJOR_VERSION 12
+#define V8_MINOR_VERSION 0
+#define V8_BUILD_NUMBER 267
+#define V8_PATCH_LEVEL 0
+`
+
+func TestGitRepo(t *testing.T) {
+	dir, rev := newTestRepo(t)
+	defer os.RemoveAll(dir)
+	ctx := context.Background()
+
+	// tag
+	tag, err := GitRepo(dir).Tag(ctx, rev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tag != "12.0.267" {
+		t.Errorf("expected tag 12.0.267, got: %s", tag)
+	}
+
+	// read file
+	buf, err := GitRepo(dir).ReadFile(ctx, "12.0.267", "include/v8-version.h")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != v8VersionH {
+		t.Errorf("expected v8-version.h, got: %q", buf)
+	}
+
+	// refs and revisions that would be interpreted as options
+	for _, s := range []string{"", "-", "--output=x", "-p"} {
+		if _, err := GitRepo(dir).Tag(ctx, s); err == nil || !strings.HasPrefix(err.Error(), "invalid revision") {
+			t.Errorf("expected invalid revision error for %q, got: %v", s, err)
+		}
+		if _, err := GitRepo(dir).ReadFile(ctx, s, "DEPS"); err == nil || !strings.HasPrefix(err.Error(), "invalid ref") {
+			t.Errorf("expected invalid ref error for %q, got: %v", s, err)
+		}
+	}
+}
+
+func TestCheckoutTag(t *testing.T) {
+	dir, rev := newTestRepo(t)
+	defer os.RemoveAll(dir)
+	ctx := context.Background()
+
+	tests := []struct {
+		rev string
+		exp string
+		err bool
+	}{
+		{rev, "12.0.267", false},
+		{strings.ToUpper(rev), "12.0.267", false},
+		{"12.0.267", "12.0.267", false},
+		{strings.Repeat("0", 40), "", true},
+		{rev[:8], "", true},
+	}
+	for i, test := range tests {
+		tag, err := Checkout(dir).Tag(ctx, test.rev)
+		switch {
+		case test.err && err == nil:
+			t.Errorf("test %d expected error, got: %s", i, tag)
+		case test.err:
+			if _, ok := err.(*NoTagError); !ok {
+				t.Errorf("test %d expected *NoTagError, got: %T %v", i, err, err)
+			}
+		case err != nil:
+			t.Errorf("test %d expected no error, got: %v", i, err)
+		case tag != test.exp:
+			t.Errorf("test %d expected %s, got: %s", i, test.exp, tag)
+		}
+	}
+
+	// not a git checkout
+	if err := os.RemoveAll(filepath.Join(dir, ".git")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Checkout(dir).Tag(ctx, rev); err == nil || !strings.Contains(err.Error(), "not a git checkout") {
+		t.Errorf("expected not a git checkout error, got: %v", err)
+	}
+	if tag, err := Checkout(dir).Tag(ctx, "12.0.267"); err != nil || tag != "12.0.267" {
+		t.Errorf("expected 12.0.267, got: %s %v", tag, err)
+	}
+}
+
+// newTestRepo creates a git repository of a v8 checkout tagged 12.0.267,
+// returning its directory and revision.
+func newTestRepo(t *testing.T) (string, string) {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir, err := ioutil.TempDir("", "cdproto-gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "include"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "include", "v8-version.h"), []byte(v8VersionH), 0644); err != nil {
+		t.Fatal(err)
+	}
+	git := func(args ...string) string {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=a", "GIT_AUTHOR_EMAIL=a@example.com", "GIT_COMMITTER_NAME=a", "GIT_COMMITTER_EMAIL=a@example.com")
+		buf, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %s: %v: %s", args[0], err, buf)
+		}
+		return strings.TrimSpace(string(buf))
+	}
+	git("init", "-q")
+	git("add", "-A")
+	git("commit", "-qm", "initial")
+	git("tag", "12.0.267")
+	return dir, git("rev-parse", "HEAD")
+}
@@ -2,24 +2,21 @@ package util
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 	"log"
-	"regexp"
-	"sort"
-	"strings"
 
-	"github.com/Masterminds/semver"
 	"github.com/PuerkitoBio/goquery"
 )
 
 const (
 	ChromiumBase = "https://chromium.googlesource.com/chromium/src"
-	ChromiumDeps = ChromiumBase + "/+/%s/DEPS"
-	ChromiumURL  = ChromiumBase + "/+/%s/third_party/blink/public/devtools_protocol/browser_protocol.pdl"
+	V8Base       = "https://chromium.googlesource.com/v8/v8"
 
-	V8Base = "https://chromium.googlesource.com/v8/v8"
-	V8URL  = V8Base + "/+/%s/include/js_protocol.pdl"
+	// ChromiumPath and V8Path are the paths of the protocol definition files
+	// in the source trees.
+	ChromiumPath = "third_party/blink/public/devtools_protocol/browser_protocol.pdl"
+	V8Path       = "include/js_protocol.pdl"
 
 	// v8 <= 7.6.303.13 uses this path. left for posterity.
 	V8URLOld = V8Base + "/+/%s/src/inspector/js_protocol.pdl"
@@ -31,30 +28,18 @@ const (
 // Logf is a shared logging function.
 var Logf = log.Printf
 
-// GetLatestVersion determines the latest tag version listed on the gitiles
-// html page.
-func GetLatestVersion(index Cache) (string, error) {
-	buf, err := Get(index)
-	if err != nil {
-		return "", err
-	}
-
+// tagsFromHTML returns the tags listed on a gitiles html page.
+func tagsFromHTML(buf []byte) ([]string, error) {
 	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(buf))
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	var vers []*semver.Version
+	var tags []string
 	doc.Find(`h3:contains("Tags") + ul li`).Each(func(i int, s *goquery.Selection) {
-		if t := s.Text(); VerRE.MatchString(t) {
-			vers = append(vers, MakeSemver(t))
-		}
+		tags = append(tags, s.Text())
 	})
-	if len(vers) < 1 {
-		return "", fmt.Errorf("could not find a valid tag at %s", index.URL)
-	}
-	sort.Sort(semver.Collection(vers))
-	return strings.Replace(vers[len(vers)-1].String(), "-", ".", -1), nil
+	return tags, nil
 }
 
 // Ref wraps a ref.
@@ -64,9 +49,9 @@ type Ref struct {
 }
 
 // GetRefs returns the refs for the url.
-func GetRefs(c Cache) (map[string]Ref, error) {
+func GetRefs(ctx context.Context, c Cache) (map[string]Ref, error) {
 	// grab refs
-	buf, err := Get(c)
+	buf, err := Get(ctx, c)
 	if err != nil {
 		return nil, err
 	}
@@ -82,43 +67,12 @@ func GetRefs(c Cache) (map[string]Ref, error) {
 	return refs, nil
 }
 
-var revRE = regexp.MustCompile(`(?is)\s+'([0-9a-f]+)'`)
-
-// GetDepVersion version retrieves the v8 version used for the browser version.
-func GetDepVersion(typ, ver string, deps, refs Cache) (string, error) {
-	buf, err := Get(deps)
+// DepRevision returns the revision of the typ dependency (ie, v8) specified
+// in the contents of a DEPS file.
+func DepRevision(typ string, buf []byte) (string, error) {
+	deps, err := ParseDeps(buf)
 	if err != nil {
 		return "", err
 	}
-
-	// determine revision
-	mark := []byte("'" + typ + "_revision':")
-	i := bytes.Index(buf, mark)
-	if i == -1 {
-		return "", fmt.Errorf("could not find revision for %s version %s", typ, ver)
-	}
-	buf = buf[i+len(mark):]
-	m := revRE.FindSubmatch(buf)
-	if m == nil {
-		return "", fmt.Errorf("no revision for %s version %s", typ, ver)
-	}
-	rev := string(m[1])
-
-	// grab refs
-	r, err := GetRefs(refs)
-	if err != nil {
-		return "", err
-	}
-
-	// find tag
-	for k, v := range r {
-		if !strings.HasPrefix(k, "refs/tags/") {
-			continue
-		}
-		if v.Value == rev {
-			return strings.TrimPrefix(k, "refs/tags/"), nil
-		}
-	}
-
-	return "", fmt.Errorf("could not find %s revision tag for rev %s", rev, m[1])
+	return deps.Revision(typ)
 }
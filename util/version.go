@@ -0,0 +1,280 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// VerRE is a regular expression for matching version numbers.
+var VerRE = regexp.MustCompile(`^[0-9]+\.[0-9]+\.[0-9]+(\.[0-9]+)?$`)
+
+// RevRE is a regular expression for matching full git commit hashes.
+var RevRE = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// ChromeVersion is a Chromium or V8 release version (ie, 120.0.6099.109 or
+// 12.0.267), having 3 or 4 numeric components.
+type ChromeVersion struct {
+	Major, Minor, Build, Patch int
+
+	// Parts is the number of components of the version (ie, 3 for V8
+	// versions without a patch level). Components beyond Parts are 0.
+	Parts int
+}
+
+// ParseChromeVersion parses a release version.
+func ParseChromeVersion(s string) (ChromeVersion, error) {
+	if !VerRE.MatchString(s) {
+		return ChromeVersion{}, fmt.Errorf("invalid version %q", s)
+	}
+	return parseVersionPrefix(s)
+}
+
+// parseVersionPrefix parses a version having 1 to 4 numeric components.
+func parseVersionPrefix(s string) (ChromeVersion, error) {
+	v := strings.Split(s, ".")
+	if len(v) > 4 {
+		return ChromeVersion{}, fmt.Errorf("invalid version %q", s)
+	}
+	var n [4]int
+	for i, z := range v {
+		var err error
+		if n[i], err = strconv.Atoi(z); err != nil || n[i] < 0 || z == "" || z[0] == '+' {
+			return ChromeVersion{}, fmt.Errorf("invalid version %q", s)
+		}
+	}
+	return ChromeVersion{
+		Major: n[0],
+		Minor: n[1],
+		Build: n[2],
+		Patch: n[3],
+		Parts: len(v),
+	}, nil
+}
+
+// String satisfies the fmt.Stringer interface.
+func (v ChromeVersion) String() string {
+	n := v.components()
+	s := make([]string, v.Parts)
+	for i := range s {
+		s[i] = strconv.Itoa(n[i])
+	}
+	return strings.Join(s, ".")
+}
+
+// components returns the components of the version.
+func (v ChromeVersion) components() [4]int {
+	return [4]int{v.Major, v.Minor, v.Build, v.Patch}
+}
+
+// Compare returns -1, 0, or 1 when v is less than, equal to, or greater than
+// w. A version without a patch level is less than the same version with a
+// patch level of 0.
+func (v ChromeVersion) Compare(w ChromeVersion) int {
+	if c := comparePrefix(v, w, 4); c != 0 {
+		return c
+	}
+	switch {
+	case v.Parts < w.Parts:
+		return -1
+	case v.Parts > w.Parts:
+		return 1
+	}
+	return 0
+}
+
+// Less returns true when v is less than w.
+func (v ChromeVersion) Less(w ChromeVersion) bool {
+	return v.Compare(w) < 0
+}
+
+// comparePrefix compares the first n components of v and w.
+func comparePrefix(v, w ChromeVersion, n int) int {
+	a, b := v.components(), w.components()
+	for i := 0; i < n; i++ {
+		switch {
+		case a[i] < b[i]:
+			return -1
+		case a[i] > b[i]:
+			return 1
+		}
+	}
+	return 0
+}
+
+// CompareVersions returns true if version a is less than version b. Invalid
+// versions are less than valid versions, and are otherwise compared as
+// strings.
+func CompareVersions(a, b string) bool {
+	v, errA := ParseChromeVersion(a)
+	w, errB := ParseChromeVersion(b)
+	switch {
+	case errA != nil && errB != nil:
+		return a < b
+	case errA != nil || errB != nil:
+		return errA != nil
+	}
+	return v.Less(w)
+}
+
+// SortVersions parses and sorts the valid release versions, ignoring any
+// other strings (ie, non-release tags).
+func SortVersions(versions []string) []ChromeVersion {
+	var v []ChromeVersion
+	for _, s := range versions {
+		if ver, err := ParseChromeVersion(s); err == nil {
+			v = append(v, ver)
+		}
+	}
+	sort.Slice(v, func(i, j int) bool {
+		return v[i].Less(v[j])
+	})
+	return v
+}
+
+// Channels are the Chrome release channels.
+var Channels = []string{"stable", "beta", "dev", "canary", "extended"}
+
+// isChannel returns true when s is a release channel.
+func isChannel(s string) bool {
+	for _, z := range Channels {
+		if s == z {
+			return true
+		}
+	}
+	return false
+}
+
+// VersionSpec is a version specifier, selecting a release version from a
+// list of versions, or the current version of a release channel.
+//
+// A version specifier is one of:
+//
+//	(empty) or latest    the highest version
+//	120.0.6099.109       the exact version
+//	<40 hex digits>      the exact (possibly untagged) git revision
+//	stable               the current version of the release channel (ie,
+//	                     stable, beta, dev, canary, or extended)
+//	latest-patch-of:118  the highest version starting with the prefix
+//	>=120 <121           the highest version satisfying all of the
+//	                     space-separated constraints (=, !=, <, <=, >, >=),
+//	                     each comparing only the components of the version
+//	                     specified in the constraint (ie, <121 is satisfied by
+//	                     120.0.6099.109, but not 121.0.6167.16)
+type VersionSpec struct {
+	// Spec is the version specifier.
+	Spec string
+
+	// Channel is the release channel, when the specifier is a channel.
+	Channel string
+
+	// Exact is the exact version or revision, when the specifier is an exact
+	// version or a git revision.
+	Exact string
+
+	constraints []versionConstraint
+}
+
+// versionConstraint is a version specifier constraint.
+type versionConstraint struct {
+	op string
+	v  ChromeVersion
+}
+
+// versionOps are the version constraint operators, longest first.
+var versionOps = []string{">=", "<=", "!=", "=", "<", ">"}
+
+// latestPatchOf is the version specifier prefix for the highest version
+// starting with a prefix.
+const latestPatchOf = "latest-patch-of:"
+
+// ParseVersionSpec parses a version specifier.
+func ParseVersionSpec(spec string) (*VersionSpec, error) {
+	s := strings.TrimSpace(spec)
+	switch {
+	case s == "" || s == "latest":
+		return &VersionSpec{Spec: spec}, nil
+	case VerRE.MatchString(s) || RevRE.MatchString(s):
+		return &VersionSpec{Spec: spec, Exact: s}, nil
+	case isChannel(strings.ToLower(s)):
+		return &VersionSpec{Spec: spec, Channel: strings.ToLower(s)}, nil
+	case strings.HasPrefix(s, latestPatchOf):
+		s = "=" + strings.TrimPrefix(s, latestPatchOf)
+	}
+
+	vs := &VersionSpec{Spec: spec}
+	for _, f := range strings.Fields(s) {
+		op := "="
+		for _, z := range versionOps {
+			if strings.HasPrefix(f, z) {
+				op, f = z, strings.TrimPrefix(f, z)
+				break
+			}
+		}
+		v, err := parseVersionPrefix(f)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version specifier %q", spec)
+		}
+		vs.constraints = append(vs.constraints, versionConstraint{op, v})
+	}
+	return vs, nil
+}
+
+// Match returns true when the version satisfies the specifier. Channels match
+// no version, as the channel's version must first be determined.
+func (spec *VersionSpec) Match(v ChromeVersion) bool {
+	switch {
+	case spec.Channel != "":
+		return false
+	case spec.Exact != "":
+		return v.String() == spec.Exact
+	}
+	for _, c := range spec.constraints {
+		n := comparePrefix(v, c.v, c.v.Parts)
+		var ok bool
+		switch c.op {
+		case "=":
+			ok = n == 0
+		case "!=":
+			ok = n != 0
+		case "<":
+			ok = n < 0
+		case "<=":
+			ok = n <= 0
+		case ">":
+			ok = n > 0
+		case ">=":
+			ok = n >= 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchString returns true when the version string satisfies the specifier.
+func (spec *VersionSpec) MatchString(s string) bool {
+	if spec.Exact != "" {
+		return s == spec.Exact
+	}
+	v, err := ParseChromeVersion(s)
+	return err == nil && spec.Match(v)
+}
+
+// Select returns the highest of the versions satisfying the specifier.
+func (spec *VersionSpec) Select(versions []string) (string, error) {
+	v := SortVersions(versions)
+	for i := len(v) - 1; i >= 0; i-- {
+		if spec.Match(v[i]) {
+			return v[i].String(), nil
+		}
+	}
+	if spec.Spec == "" || spec.Spec == "latest" {
+		return "", errors.New("could not find a valid version tag")
+	}
+	return "", fmt.Errorf("could not find a version tag matching %q", spec.Spec)
+}
@@ -0,0 +1,243 @@
+package util
+
+import (
+	"strings"
+	"testing"
+)
+
+// testRev is an untagged git revision.
+const testRev = "0123456789abcdef0123456789abcdef01234567"
+
+func TestParseChromeVersion(t *testing.T) {
+	tests := []struct {
+		s   string
+		exp ChromeVersion
+		err bool
+	}{
+		{"120.0.6099.109", ChromeVersion{120, 0, 6099, 109, 4}, false},
+		{"12.0.267", ChromeVersion{12, 0, 267, 0, 3}, false},
+		{"0.0.0.0", ChromeVersion{0, 0, 0, 0, 4}, false},
+		{"", ChromeVersion{}, true},
+		{"120", ChromeVersion{}, true},
+		{"120.0", ChromeVersion{}, true},
+		{"1.2.3.4.5", ChromeVersion{}, true},
+		{"v1.2.3", ChromeVersion{}, true},
+		{"1.2.3-rc1", ChromeVersion{}, true},
+		{"1..3", ChromeVersion{}, true},
+		{"99999999999999999999.0.0", ChromeVersion{}, true},
+		{testRev, ChromeVersion{}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.s, func(t *testing.T) {
+			v, err := ParseChromeVersion(test.s)
+			switch {
+			case test.err && err == nil:
+				t.Fatalf("expected error, got: %v", v)
+			case test.err:
+				return
+			case err != nil:
+				t.Fatal(err)
+			}
+			if v != test.exp {
+				t.Errorf("expected %#v, got: %#v", test.exp, v)
+			}
+			if s := v.String(); s != test.s {
+				t.Errorf("expected %q, got: %q", test.s, s)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		exp  int
+	}{
+		{"120.0.6099.109", "120.0.6099.109", 0},
+		{"120.0.6099.109", "120.0.6099.110", -1},
+		{"120.0.6099.110", "120.0.6099.109", 1},
+		{"120.0.6099.109", "121.0.0.0", -1},
+		{"120.9.9999.999", "121.0.0.0", -1},
+		{"120.0.6099.5", "120.0.6099.109", -1},
+		{"12.0.267", "12.0.267", 0},
+		{"12.0.267", "12.0.267.0", -1},
+		{"12.0.267.0", "12.0.267", 1},
+		{"12.0.267.1", "12.0.268", -1},
+	}
+	for _, test := range tests {
+		v, err := ParseChromeVersion(test.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w, err := ParseChromeVersion(test.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n := v.Compare(w); n != test.exp {
+			t.Errorf("%s compare %s expected %d, got: %d", test.a, test.b, test.exp, n)
+		}
+		if b := v.Less(w); b != (test.exp < 0) {
+			t.Errorf("%s less %s expected %t, got: %t", test.a, test.b, test.exp < 0, b)
+		}
+	}
+}
+
+func TestSortVersions(t *testing.T) {
+	v := SortVersions([]string{"121.0.6167.16", "v1.0", "120.0.6099.109", "12.0.267", testRev, "120.0.6099.5", "12.0.267.0"})
+	var s []string
+	for _, z := range v {
+		s = append(s, z.String())
+	}
+	if str, exp := strings.Join(s, " "), "12.0.267 12.0.267.0 120.0.6099.5 120.0.6099.109 121.0.6167.16"; str != exp {
+		t.Errorf("expected %s, got: %s", exp, str)
+	}
+}
+
+func TestParseVersionSpec(t *testing.T) {
+	tests := []struct {
+		spec    string
+		channel string
+		exact   string
+		err     bool
+	}{
+		{"", "", "", false},
+		{"latest", "", "", false},
+		{" latest ", "", "", false},
+		{"120.0.6099.109", "", "120.0.6099.109", false},
+		{"12.0.267", "", "12.0.267", false},
+		{testRev, "", testRev, false},
+		{"stable", "stable", "", false},
+		{"Beta", "beta", "", false},
+		{"extended", "extended", "", false},
+		{"latest-patch-of:118", "", "", false},
+		{"latest-patch-of:120.0.6099", "", "", false},
+		{">=120 <121", "", "", false},
+		{"!=120.0.6099.109", "", "", false},
+		{"120", "", "", false},
+		{"foo", "", "", true},
+		{"nightly", "", "", true},
+		{"latest-patch-of:", "", "", true},
+		{"latest-patch-of:x", "", "", true},
+		{">=", "", "", true},
+		{">=abc", "", "", true},
+		{"=>120", "", "", true},
+		{"<+120", "", "", true},
+		{"1.2.3.4.5", "", "", true},
+		{strings.ToUpper(testRev), "", "", true},
+		{testRev[:39], "", "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.spec, func(t *testing.T) {
+			vs, err := ParseVersionSpec(test.spec)
+			switch {
+			case test.err && err == nil:
+				t.Fatalf("expected error, got: %#v", vs)
+			case test.err:
+				if s := `invalid version specifier "` + test.spec + `"`; err.Error() != s {
+					t.Errorf("expected error %q, got: %v", s, err)
+				}
+				return
+			case err != nil:
+				t.Fatal(err)
+			}
+			if vs.Spec != test.spec || vs.Channel != test.channel || vs.Exact != test.exact {
+				t.Errorf("expected spec %q, channel %q, exact %q, got: %#v", test.spec, test.channel, test.exact, vs)
+			}
+		})
+	}
+}
+
+// testVersions are the versions selected from in TestSelect.
+var testVersions = []string{
+	"118.0.5993.70",
+	"118.0.5993.117",
+	"119.0.6045.105",
+	"120.0.6099.5",
+	"120.0.6099.109",
+	"121.0.6167.16",
+	"12.0.267",
+	"v1.0",
+}
+
+func TestSelect(t *testing.T) {
+	tests := []struct {
+		spec string
+		exp  string
+		err  string
+	}{
+		{"", "121.0.6167.16", ""},
+		{"latest", "121.0.6167.16", ""},
+		{"120.0.6099.5", "120.0.6099.5", ""},
+		{"120.0.6099.6", "", `could not find a version tag matching "120.0.6099.6"`},
+		{"latest-patch-of:118", "118.0.5993.117", ""},
+		{"latest-patch-of:120.0.6099", "120.0.6099.109", ""},
+		{"latest-patch-of:117", "", `could not find a version tag matching "latest-patch-of:117"`},
+		{"120", "120.0.6099.109", ""},
+		{">=119 <121", "120.0.6099.109", ""},
+		{">119 <=120", "120.0.6099.109", ""},
+		{"<120", "119.0.6045.105", ""},
+		{"<120 !=119", "118.0.5993.117", ""},
+		{"<=120.0.6099.5", "120.0.6099.5", ""},
+		{"!=121", "120.0.6099.109", ""},
+		{"<13", "12.0.267", ""},
+		{">=122", "", `could not find a version tag matching ">=122"`},
+		{"stable", "", `could not find a version tag matching "stable"`},
+		{testRev, "", `could not find a version tag matching "` + testRev + `"`},
+	}
+	for _, test := range tests {
+		t.Run(test.spec, func(t *testing.T) {
+			vs, err := ParseVersionSpec(test.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ver, err := vs.Select(testVersions)
+			switch {
+			case test.err != "" && (err == nil || err.Error() != test.err):
+				t.Fatalf("expected error %q, got: %v", test.err, err)
+			case test.err == "" && err != nil:
+				t.Fatal(err)
+			}
+			if ver != test.exp {
+				t.Errorf("expected %q, got: %q", test.exp, ver)
+			}
+		})
+	}
+
+	// no versions
+	vs, err := ParseVersionSpec("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vs.Select([]string{"v1.0"}); err == nil || err.Error() != "could not find a valid version tag" {
+		t.Errorf("expected no valid version error, got: %v", err)
+	}
+}
+
+func TestMatchString(t *testing.T) {
+	tests := []struct {
+		spec, s string
+		exp     bool
+	}{
+		{"", "120.0.6099.109", true},
+		{"", "v1.0", false},
+		{"120.0.6099.109", "120.0.6099.109", true},
+		{"120.0.6099.109", "120.0.6099.110", false},
+		{testRev, testRev, true},
+		{testRev, "120.0.6099.109", false},
+		{"stable", "120.0.6099.109", false},
+		{"latest-patch-of:120", "120.0.6099.109", true},
+		{"latest-patch-of:120", "121.0.6167.16", false},
+		{">=12 <13", "12.0.267", true},
+		{">=12 <13", "12.0.267.1", true},
+		{">=12 <13", testRev, false},
+	}
+	for _, test := range tests {
+		vs, err := ParseVersionSpec(test.spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b := vs.MatchString(test.s); b != test.exp {
+			t.Errorf("spec %q match %q expected %t, got: %t", test.spec, test.s, test.exp, b)
+		}
+	}
+}
@@ -0,0 +1,355 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"flag"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"hash"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/chromedp/cdproto-gen/gen"
+	"github.com/chromedp/cdproto-gen/util"
+)
+
+// versionsGo is the name of the index of the versions generated with
+// -versions, written to the out directory.
+const versionsGo = "versions.go"
+
+// genVersion is a protocol version generated with -versions.
+type genVersion struct {
+	chromium, v8 string
+
+	// dir is the version's package root relative to the out directory (ie,
+	// v120), and goPkg its import path.
+	dir   string
+	goPkg string
+
+	// files are the generated files, keyed by path relative to the out
+	// directory.
+	files map[string]*bytes.Buffer
+
+	// pkgs are the generated packages relative to dir (ie, "", cdp, page).
+	pkgs []string
+
+	// hashes are the hashes of the contents of each package (except the
+	// root), with the version's import path removed, and imports are the
+	// packages of the version each package imports.
+	hashes  map[string]string
+	imports map[string][]string
+
+	// owners are the versions whose packages are used for each package.
+	owners map[string]*genVersion
+}
+
+// generateVersions generates each of the chromium versions specified by
+// -versions into its own package root (ie, <go-pkg>/v120), sharing each
+// package identical to that of an earlier version, and writes an index of the
+// versions and their packages to the out directory.
+func generateVersions(lk *locker) error {
+	set := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+	for _, name := range []string{"chromium", "v8", "latest", "pdl", "endpoint", "graph"} {
+		if set[name] {
+			return fmt.Errorf("-%s cannot be used with -versions", name)
+		}
+	}
+
+	// get generator
+	generator := gen.Generators()["go"]
+	if generator == nil {
+		return errors.New("no generator")
+	}
+
+	// generate versions
+	var versions []*genVersion
+	dirs := make(map[string]string)
+	for _, spec := range strings.Split(*flagVersions, ",") {
+		if spec = strings.TrimSpace(spec); spec == "" {
+			continue
+		}
+		v, err := generateVersion(lk, generator, spec)
+		if err != nil {
+			return fmt.Errorf("%s: %v", spec, err)
+		}
+		if z, ok := dirs[v.dir]; ok {
+			return fmt.Errorf("chromium %s and %s would both be generated into %s", z, v.chromium, v.dir)
+		}
+		dirs[v.dir] = v.chromium
+		versions = append(versions, v)
+	}
+	if len(versions) == 0 {
+		return errors.New("no versions specified")
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return util.CompareVersions(versions[i].chromium, versions[j].chromium)
+	})
+
+	// share packages
+	shareVersions(versions)
+	files := map[string]*bytes.Buffer{
+		versionsGo: versionsFile(path.Base(*flagGoPkg), versions),
+	}
+	var pkgs []string
+	for _, v := range versions {
+		var shared int
+		for _, pkg := range v.pkgs {
+			if owner := v.owners[pkg]; owner != nil && owner != v {
+				shared++
+				continue
+			}
+			pkgs = append(pkgs, filepath.Join(v.dir, pkg))
+		}
+		util.Logf("VERSION: %s -> %s (%d packages, %d shared)", v.chromium, v.goPkg, len(v.pkgs), shared)
+		for k, buf := range v.files {
+			if owner := v.owners[v.pkg(k)]; owner != nil && owner != v {
+				continue
+			}
+			files[k] = v.rewrite(buf)
+		}
+	}
+
+	// write files
+	if err := output(files, pkgs); err != nil {
+		return err
+	}
+
+	util.Logf("done.")
+	return nil
+}
+
+// generateVersion loads, processes, and generates the protocol definitions
+// for the chromium version specifier.
+func generateVersion(lk *locker, generator gen.Generator, spec string) (*genVersion, error) {
+	*flagChromium, *flagV8 = spec, ""
+
+	// load protocol definitions
+	protoDefs, _, err := loadProtocol(lk)
+	if err != nil {
+		return nil, err
+	}
+	if err = validate(protoDefs); err != nil {
+		return nil, err
+	}
+	sortDomains(protoDefs)
+	if len(*flagOverlay) == 0 {
+		if _, err = writeCombined(protoDefs); err != nil {
+			return nil, err
+		}
+	}
+	ver, err := util.ParseChromeVersion(*flagChromium)
+	if err != nil {
+		return nil, fmt.Errorf("cannot determine package root for chromium %s", *flagChromium)
+	}
+
+	// determine supported versions
+	var c *compat
+	if *flagCompat != "" {
+		if c, err = loadCompat(lk, protoDefs, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	// determine what to process
+	processed, pkgs, err := processDomains(protoDefs)
+	if err != nil {
+		return nil, err
+	}
+	if c != nil {
+		c.apply(processed)
+	}
+
+	// emit
+	v := &genVersion{
+		chromium: *flagChromium,
+		v8:       *flagV8,
+		dir:      fmt.Sprintf("v%d", ver.Major),
+		files:    make(map[string]*bytes.Buffer),
+		pkgs:     pkgs,
+		hashes:   make(map[string]string),
+		imports:  make(map[string][]string),
+	}
+	v.goPkg = path.Join(*flagGoPkg, v.dir)
+	emitter, err := generator(processed, v.goPkg)
+	if err != nil {
+		return nil, err
+	}
+	for k, buf := range emitter.Emit() {
+		v.files[filepath.Join(v.dir, k)] = buf
+	}
+	if c != nil {
+		v.files[filepath.Join(v.dir, compatGo)] = c.file(path.Base(v.goPkg), processed)
+	}
+
+	// remove unused imports, so that packages are only compared with their
+	// imported packages
+	if err = goimports(v.files); err != nil {
+		return nil, err
+	}
+
+	// hash packages and determine imports
+	var keys []string
+	for k := range v.files {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	prefix := `"` + v.goPkg + "/"
+	hashes := make(map[string]hash.Hash)
+	for _, k := range keys {
+		pkg := v.pkg(k)
+		if pkg == "" {
+			continue
+		}
+		h, ok := hashes[pkg]
+		if !ok {
+			h = sha256.New()
+			hashes[pkg] = h
+		}
+		buf := v.files[k].Bytes()
+		fmt.Fprintf(h, "%s\x00", path.Base(filepath.ToSlash(k)))
+		h.Write(bytes.Replace(buf, []byte(prefix), []byte(`"`), -1))
+		h.Write([]byte{0})
+
+		f, err := parser.ParseFile(token.NewFileSet(), k, buf, parser.ImportsOnly)
+		if err != nil {
+			return nil, err
+		}
+		for _, imp := range f.Imports {
+			s, _ := strconv.Unquote(imp.Path.Value)
+			if strings.HasPrefix(s, v.goPkg+"/") {
+				v.imports[pkg] = append(v.imports[pkg], strings.TrimPrefix(s, v.goPkg+"/"))
+			}
+		}
+	}
+	for pkg, h := range hashes {
+		v.hashes[pkg] = fmt.Sprintf("%x", h.Sum(nil))
+	}
+	return v, nil
+}
+
+// pkg returns the package of the generated file relative to the version's
+// package root.
+func (v *genVersion) pkg(name string) string {
+	dir := path.Dir(filepath.ToSlash(name))
+	if dir == v.dir {
+		return ""
+	}
+	return strings.TrimPrefix(dir, v.dir+"/")
+}
+
+// rewrite rewrites the imports of the packages shared with earlier versions
+// to the packages of the earlier versions.
+func (v *genVersion) rewrite(buf *bytes.Buffer) *bytes.Buffer {
+	b := buf.Bytes()
+	for _, pkg := range v.pkgs {
+		if owner := v.owners[pkg]; owner != nil && owner != v {
+			b = bytes.Replace(b, []byte(strconv.Quote(v.goPkg+"/"+pkg)), []byte(strconv.Quote(owner.goPkg+"/"+pkg)), -1)
+		}
+	}
+	return bytes.NewBuffer(b)
+}
+
+// shareVersions determines the owners of the packages of the versions,
+// ordered oldest first. A package is shared with (owned by) the earliest
+// version whose package has identical contents and whose imported packages
+// have the same owners. The root packages are never shared.
+func shareVersions(versions []*genVersion) {
+	for i, v := range versions {
+		v.owners = make(map[string]*genVersion)
+		var share func(string)
+		share = func(pkg string) {
+			if _, ok := v.owners[pkg]; ok {
+				return
+			}
+			v.owners[pkg] = v
+			for _, z := range v.imports[pkg] {
+				share(z)
+			}
+			for _, w := range versions[:i] {
+				if w.hashes[pkg] != v.hashes[pkg] {
+					continue
+				}
+				same := true
+				for _, z := range v.imports[pkg] {
+					same = same && v.owners[z] == w.owners[z]
+				}
+				if same {
+					v.owners[pkg] = w.owners[pkg]
+					return
+				}
+			}
+		}
+		for _, pkg := range v.pkgs {
+			if pkg != "" {
+				share(pkg)
+			}
+		}
+	}
+}
+
+// versionsFile returns the go source for the index of the versions generated
+// with -versions.
+func versionsFile(pkgName string, versions []*genVersion) *bytes.Buffer {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "// Code generated by cdproto-gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "// Package %s indexes the Chrome DevTools Protocol versions generated into\n", pkgName)
+	fmt.Fprintf(buf, "// the versioned packages.\n")
+	fmt.Fprintf(buf, "package %s\n\n", pkgName)
+	fmt.Fprintf(buf, "import \"strings\"\n\n")
+	fmt.Fprintf(buf, "// Version is a generated protocol version.\n")
+	fmt.Fprintf(buf, "type Version struct {\n")
+	fmt.Fprintf(buf, "\t// Chromium and V8 are the versions of the protocol definitions.\n")
+	fmt.Fprintf(buf, "\tChromium string\n")
+	fmt.Fprintf(buf, "\tV8       string\n\n")
+	fmt.Fprintf(buf, "\t// Package is the import path of the version's root package.\n")
+	fmt.Fprintf(buf, "\tPackage string\n\n")
+	fmt.Fprintf(buf, "\t// Packages are the import paths of the version's packages, keyed by\n")
+	fmt.Fprintf(buf, "\t// package name. Packages identical to those of an earlier version are\n")
+	fmt.Fprintf(buf, "\t// shared with the earlier version.\n")
+	fmt.Fprintf(buf, "\tPackages map[string]string\n")
+	fmt.Fprintf(buf, "}\n\n")
+	fmt.Fprintf(buf, "// Versions are the generated protocol versions, oldest first.\n")
+	fmt.Fprintf(buf, "var Versions = []Version{\n")
+	for _, v := range versions {
+		fmt.Fprintf(buf, "\t{\n")
+		fmt.Fprintf(buf, "\t\tChromium: %q,\n", v.chromium)
+		fmt.Fprintf(buf, "\t\tV8:       %q,\n", v.v8)
+		fmt.Fprintf(buf, "\t\tPackage:  %q,\n", v.goPkg)
+		fmt.Fprintf(buf, "\t\tPackages: map[string]string{\n")
+		var pkgs []string
+		for _, pkg := range v.pkgs {
+			if pkg != "" {
+				pkgs = append(pkgs, pkg)
+			}
+		}
+		sort.Strings(pkgs)
+		for _, pkg := range pkgs {
+			fmt.Fprintf(buf, "\t\t\t%q: %q,\n", pkg, v.owners[pkg].goPkg+"/"+pkg)
+		}
+		fmt.Fprintf(buf, "\t\t},\n")
+		fmt.Fprintf(buf, "\t},\n")
+	}
+	fmt.Fprintf(buf, "}\n\n")
+	fmt.Fprintf(buf, "// FindVersion returns the generated version having the same major version\n")
+	fmt.Fprintf(buf, "// as the browser version (ie, 120.0.6099.109 or HeadlessChrome/120.0.6099.109).\n")
+	fmt.Fprintf(buf, "func FindVersion(browser string) (Version, bool) {\n")
+	fmt.Fprintf(buf, "\tbrowser = browser[strings.LastIndex(browser, \"/\")+1:]\n")
+	fmt.Fprintf(buf, "\tmajor := strings.SplitN(browser, \".\", 2)[0]\n")
+	fmt.Fprintf(buf, "\tfor _, v := range Versions {\n")
+	fmt.Fprintf(buf, "\t\tif strings.SplitN(v.Chromium, \".\", 2)[0] == major {\n")
+	fmt.Fprintf(buf, "\t\t\treturn v, true\n")
+	fmt.Fprintf(buf, "\t\t}\n")
+	fmt.Fprintf(buf, "\t}\n")
+	fmt.Fprintf(buf, "\treturn Version{}, false\n")
+	fmt.Fprintf(buf, "}\n")
+	return buf
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"strings"
+	"testing"
+)
+
+// newGenVersion creates a generated version of the chromium version, with the
+// hashes and imports of its packages.
+func newGenVersion(chromium string, hashes map[string]string, imports map[string][]string) *genVersion {
+	dir := "v" + strings.SplitN(chromium, ".", 2)[0]
+	v := &genVersion{
+		chromium: chromium,
+		dir:      dir,
+		goPkg:    "example.com/cdproto/" + dir,
+		pkgs:     []string{""},
+		hashes:   hashes,
+		imports:  imports,
+	}
+	for _, pkg := range []string{"cdp", "dom", "io", "page", "runtime"} {
+		if _, ok := hashes[pkg]; ok {
+			v.pkgs = append(v.pkgs, pkg)
+		}
+	}
+	return v
+}
+
+func TestShareVersions(t *testing.T) {
+	imports := map[string][]string{
+		"dom":     {"page"},
+		"page":    {"cdp"},
+		"runtime": {"cdp"},
+	}
+	// v119 changes runtime, and v120 changes cdp, so that none of its
+	// importers (including dom, importing it through page) are shared
+	v118 := newGenVersion("118.0.5993.117", map[string]string{"cdp": "a", "dom": "b", "io": "c", "page": "d", "runtime": "e"}, imports)
+	v119 := newGenVersion("119.0.6045.105", map[string]string{"cdp": "a", "dom": "b", "io": "c", "page": "d", "runtime": "f"}, imports)
+	v120 := newGenVersion("120.0.6099.109", map[string]string{"cdp": "g", "dom": "b", "io": "c", "page": "d", "runtime": "f"}, imports)
+	versions := []*genVersion{v118, v119, v120}
+	shareVersions(versions)
+
+	exp := map[*genVersion]map[string]*genVersion{
+		v118: {"cdp": v118, "dom": v118, "io": v118, "page": v118, "runtime": v118},
+		v119: {"cdp": v118, "dom": v118, "io": v118, "page": v118, "runtime": v119},
+		v120: {"cdp": v120, "dom": v120, "io": v118, "page": v120, "runtime": v120},
+	}
+	for _, v := range versions {
+		if _, ok := v.owners[""]; ok {
+			t.Errorf("%s expected root package to not be shared", v.dir)
+		}
+		for pkg, owner := range exp[v] {
+			switch z := v.owners[pkg]; {
+			case z == nil:
+				t.Errorf("%s/%s expected owner %s, got: none", v.dir, pkg, owner.dir)
+			case z != owner:
+				t.Errorf("%s/%s expected owner %s, got: %s", v.dir, pkg, owner.dir, z.dir)
+			}
+		}
+	}
+
+	// rewrite
+	buf := bytes.NewBufferString(`package dom
+
+import (
+	"example.com/cdproto/v120/cdp"
+	"example.com/cdproto/v120/io"
+	"example.com/cdproto/v120/iox"
+	"example.com/cdproto/v120/page"
+)
+`)
+	if s, exp := v120.rewrite(buf).String(), `package dom
+
+import (
+	"example.com/cdproto/v120/cdp"
+	"example.com/cdproto/v118/io"
+	"example.com/cdproto/v120/iox"
+	"example.com/cdproto/v120/page"
+)
+`; s != exp {
+		t.Errorf("expected:\n%s\ngot:\n%s", exp, s)
+	}
+	buf = bytes.NewBufferString(`import "example.com/cdproto/v119/cdp"` + "\n" + `import "example.com/cdproto/v119/runtime"` + "\n")
+	if s, exp := v119.rewrite(buf).String(), `import "example.com/cdproto/v118/cdp"`+"\n"+`import "example.com/cdproto/v119/runtime"`+"\n"; s != exp {
+		t.Errorf("expected:\n%s\ngot:\n%s", exp, s)
+	}
+
+	// index
+	buf = versionsFile("cdproto", versions)
+	if _, err := format.Source(buf.Bytes()); err != nil {
+		t.Fatalf("versions file is not valid go: %v", err)
+	}
+	for _, s := range []string{
+		"\t\tPackage:  \"example.com/cdproto/v120\",\n",
+		"\t\t\t\"io\": \"example.com/cdproto/v118/io\",\n",
+		"\t\t\t\"runtime\": \"example.com/cdproto/v119/runtime\",\n",
+	} {
+		if !strings.Contains(buf.String(), s) {
+			t.Errorf("expected versions file to contain %q, got:\n%s", s, buf)
+		}
+	}
+}